@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -162,3 +163,68 @@ func (s *ActionsService) DeleteArtifact(ctx context.Context, owner, repo string,
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// GetArtifactStream downloads the zip archive of an artifact from the
+// location returned by DownloadArtifact. The returned ReadCloser streams the
+// archive, and must be closed by the caller. The archive is fetched with
+// http.DefaultClient, so that the credentials of the client are not sent to
+// the storage host GitHub redirects to.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/artifacts/#download-an-artifact
+func (s *ActionsService) GetArtifactStream(ctx context.Context, owner, repo string, artifactID int64) (io.ReadCloser, *Response, error) {
+	archiveURL, resp, err := s.DownloadArtifact(ctx, owner, repo, artifactID, false)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	body, err := downloadFromStorage(ctx, archiveURL)
+	if err != nil {
+		return nil, resp, err
+	}
+	return body, resp, nil
+}
+
+// NoSuccessfulRunError is returned by GetLatestSuccessfulRunArtifacts when
+// a workflow has no successful run on the requested branch.
+type NoSuccessfulRunError struct {
+	Workflow string
+	Branch   string
+}
+
+func (e *NoSuccessfulRunError) Error() string {
+	return fmt.Sprintf("workflow %v has no successful run on branch %v", e.Workflow, e.Branch)
+}
+
+// GetLatestSuccessfulRunArtifacts lists all the artifacts of the most recent
+// successful run of a workflow on a branch. workflowFileOrID is either the
+// file name of the workflow, such as "build.yml", or its ID. Artifacts can
+// then be downloaded with GetArtifactStream, unless they have expired.
+//
+// If the workflow has no successful run on branch, a *NoSuccessfulRunError
+// is returned.
+func (s *ActionsService) GetLatestSuccessfulRunArtifacts(ctx context.Context, owner, repo, workflowFileOrID, branch string) ([]*Artifact, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/runs", owner, repo, workflowFileOrID)
+	opts := &ListWorkflowRunsOptions{Branch: branch, Status: "success", ListOptions: ListOptions{PerPage: 1}}
+	runs, resp, err := s.listWorkflowRuns(ctx, u, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(runs.WorkflowRuns) == 0 {
+		return nil, resp, &NoSuccessfulRunError{Workflow: workflowFileOrID, Branch: branch}
+	}
+
+	var artifacts []*Artifact
+	listOpts := &ListOptions{PerPage: 100}
+	resp, err = paginate(s.client.maxPages, listOpts, func() (*Response, error) {
+		list, resp, err := s.ListWorkflowRunArtifacts(ctx, owner, repo, runs.WorkflowRuns[0].GetID(), listOpts)
+		if list != nil {
+			artifacts = append(artifacts, list.Artifacts...)
+		}
+		return resp, err
+	})
+	if err != nil {
+		return artifacts, resp, err
+	}
+
+	return artifacts, resp, nil
+}
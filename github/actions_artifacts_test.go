@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -339,3 +340,83 @@ func TestActionsService_DeleteArtifact_notFound(t *testing.T) {
 		t.Errorf("Actions.DeleteArtifact return status %d, want %d", got, want)
 	}
 }
+
+func TestActionsService_GetArtifactStream(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client = client.WithTokenSource(StaticTokenSource("t"))
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "token t")
+		http.Redirect(w, r, serverURL+baseURLPath+"/artifact-archive", http.StatusFound)
+	})
+	mux.HandleFunc("/artifact-archive", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("storage request sent Authorization header %q", auth)
+		}
+		fmt.Fprint(w, "zip data")
+	})
+
+	body, _, err := client.Actions.GetArtifactStream(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Actions.GetArtifactStream returned error: %v", err)
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("reading artifact archive: %v", err)
+	}
+	if want := "zip data"; string(data) != want {
+		t.Errorf("Actions.GetArtifactStream returned %q, want %q", data, want)
+	}
+}
+
+func TestActionsService_GetLatestSuccessfulRunArtifacts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/build.yml/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"branch": "main", "status": "success", "per_page": "1"})
+		fmt.Fprint(w, `{"total_count":2,"workflow_runs":[{"id":7}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/runs/7/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/actions/runs/7/artifacts?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":2,"artifacts":[{"id":1,"name":"dist"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":2,"artifacts":[{"id":2,"name":"coverage"}]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	artifacts, _, err := client.Actions.GetLatestSuccessfulRunArtifacts(context.Background(), "o", "r", "build.yml", "main")
+	if err != nil {
+		t.Fatalf("Actions.GetLatestSuccessfulRunArtifacts returned error: %v", err)
+	}
+
+	want := []*Artifact{{ID: Int64(1), Name: String("dist")}, {ID: Int64(2), Name: String("coverage")}}
+	if !reflect.DeepEqual(artifacts, want) {
+		t.Errorf("Actions.GetLatestSuccessfulRunArtifacts returned %+v, want %+v", artifacts, want)
+	}
+}
+
+func TestActionsService_GetLatestSuccessfulRunArtifacts_noSuccessfulRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/42/runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":0,"workflow_runs":[]}`)
+	})
+
+	_, _, err := client.Actions.GetLatestSuccessfulRunArtifacts(context.Background(), "o", "r", "42", "main")
+	want := &NoSuccessfulRunError{Workflow: "42", Branch: "main"}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Actions.GetLatestSuccessfulRunArtifacts returned error %v, want %v", err, want)
+	}
+}
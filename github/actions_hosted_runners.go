@@ -0,0 +1,162 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// HostedRunner represents a GitHub-hosted larger runner of an organization.
+// Workflows select it by using its Name as their runs-on label.
+type HostedRunner struct {
+	ID                 *int64                   `json:"id,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	RunnerGroupID      *int64                   `json:"runner_group_id,omitempty"`
+	Platform           *string                  `json:"platform,omitempty"`
+	ImageDetails       *HostedRunnerImageDetail `json:"image_details,omitempty"`
+	MachineSizeDetails *HostedRunnerMachineSpec `json:"machine_size_details,omitempty"`
+	// Status is the status of the runner. Possible values are: Ready,
+	// Provisioning, Shutdown, Deleting, Stuck.
+	Status          *string                 `json:"status,omitempty"`
+	MaximumRunners  *int64                  `json:"maximum_runners,omitempty"`
+	PublicIPEnabled *bool                   `json:"public_ip_enabled,omitempty"`
+	PublicIPs       []*HostedRunnerPublicIP `json:"public_ips,omitempty"`
+	LastActiveOn    *Timestamp              `json:"last_active_on,omitempty"`
+}
+
+func (h HostedRunner) String() string {
+	return Stringify(h)
+}
+
+// HostedRunnerImageDetail represents the image a GitHub-hosted runner runs.
+type HostedRunnerImageDetail struct {
+	ID          *string `json:"id,omitempty"`
+	SizeGB      *int64  `json:"size_gb,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	// Source is the source of the image. Possible values are: github,
+	// partner, custom.
+	Source *string `json:"source,omitempty"`
+}
+
+// HostedRunnerMachineSpec represents a machine size of GitHub-hosted
+// runners.
+type HostedRunnerMachineSpec struct {
+	ID        *string `json:"id,omitempty"`
+	CPUCores  *int    `json:"cpu_cores,omitempty"`
+	MemoryGB  *int    `json:"memory_gb,omitempty"`
+	StorageGB *int    `json:"storage_gb,omitempty"`
+}
+
+func (h HostedRunnerMachineSpec) String() string {
+	return Stringify(h)
+}
+
+// HostedRunnerPublicIP represents a static public IP range of a
+// GitHub-hosted runner.
+type HostedRunnerPublicIP struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Prefix  *string `json:"prefix,omitempty"`
+	Length  *int    `json:"length,omitempty"`
+}
+
+// HostedRunnerImage represents an image that GitHub-hosted runners can run.
+type HostedRunnerImage struct {
+	ID          *string `json:"id,omitempty"`
+	Platform    *string `json:"platform,omitempty"`
+	SizeGB      *int64  `json:"size_gb,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Source      *string `json:"source,omitempty"`
+}
+
+// HostedRunners represents a list of GitHub-hosted runners.
+type HostedRunners struct {
+	TotalCount int             `json:"total_count"`
+	Runners    []*HostedRunner `json:"runners"`
+}
+
+// HostedRunnerMachineSpecs represents a list of machine sizes of
+// GitHub-hosted runners.
+type HostedRunnerMachineSpecs struct {
+	TotalCount   int                        `json:"total_count"`
+	MachineSpecs []*HostedRunnerMachineSpec `json:"machine_specs"`
+}
+
+// HostedRunnerImages represents a list of images GitHub-hosted runners can
+// run.
+type HostedRunnerImages struct {
+	TotalCount int                  `json:"total_count"`
+	Images     []*HostedRunnerImage `json:"images"`
+}
+
+// ListHostedRunners lists the GitHub-hosted larger runners configured in an
+// organization, with their machine size and image. GitHub-hosted runners
+// are configured at the organization level only; the larger runners a
+// repository can use are those of the runner groups it has access to.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#list-github-hosted-runners-for-an-organization
+func (s *ActionsService) ListHostedRunners(ctx context.Context, org string, opts *ListOptions) (*HostedRunners, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runners := new(HostedRunners)
+	resp, err := s.client.Do(ctx, req, runners)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runners, resp, nil
+}
+
+// ListHostedRunnerMachineSpecs lists the machine sizes, with their CPU
+// cores, memory and storage, available for GitHub-hosted runners in an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#get-github-hosted-runners-machine-specs-for-an-organization
+func (s *ActionsService) ListHostedRunnerMachineSpecs(ctx context.Context, org string) (*HostedRunnerMachineSpecs, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/machine-sizes", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specs := new(HostedRunnerMachineSpecs)
+	resp, err := s.client.Do(ctx, req, specs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return specs, resp, nil
+}
+
+// ListHostedRunnerGitHubOwnedImages lists the GitHub-owned images, with
+// their platform (operating system and architecture), available for
+// GitHub-hosted runners in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#get-github-owned-images-for-github-hosted-runners-in-an-organization
+func (s *ActionsService) ListHostedRunnerGitHubOwnedImages(ctx context.Context, org string) (*HostedRunnerImages, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/images/github-owned", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	images := new(HostedRunnerImages)
+	resp, err := s.client.Do(ctx, req, images)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return images, resp, nil
+}
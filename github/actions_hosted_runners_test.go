@@ -0,0 +1,129 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActionsService_ListHostedRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{
+			"total_count": 1,
+			"runners": [{
+				"id": 5,
+				"name": "ubuntu-8-core",
+				"runner_group_id": 2,
+				"platform": "linux-x64",
+				"image_details": {"id": "ubuntu-22.04", "size_gb": 86, "display_name": "22.04", "source": "github"},
+				"machine_size_details": {"id": "8-core", "cpu_cores": 8, "memory_gb": 32, "storage_gb": 300},
+				"status": "Ready",
+				"maximum_runners": 10,
+				"public_ip_enabled": true,
+				"public_ips": [{"enabled": true, "prefix": "20.80.208.150", "length": 31}],
+				"last_active_on": "2023-04-26T15:23:37Z"
+			}]
+		}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	runners, _, err := client.Actions.ListHostedRunners(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunners returned error: %v", err)
+	}
+
+	want := &HostedRunners{
+		TotalCount: 1,
+		Runners: []*HostedRunner{{
+			ID:                 Int64(5),
+			Name:               String("ubuntu-8-core"),
+			RunnerGroupID:      Int64(2),
+			Platform:           String("linux-x64"),
+			ImageDetails:       &HostedRunnerImageDetail{ID: String("ubuntu-22.04"), SizeGB: Int64(86), DisplayName: String("22.04"), Source: String("github")},
+			MachineSizeDetails: &HostedRunnerMachineSpec{ID: String("8-core"), CPUCores: Int(8), MemoryGB: Int(32), StorageGB: Int(300)},
+			Status:             String("Ready"),
+			MaximumRunners:     Int64(10),
+			PublicIPEnabled:    Bool(true),
+			PublicIPs:          []*HostedRunnerPublicIP{{Enabled: Bool(true), Prefix: String("20.80.208.150"), Length: Int(31)}},
+			LastActiveOn:       &Timestamp{time.Date(2023, time.April, 26, 15, 23, 37, 0, time.UTC)},
+		}},
+	}
+	if !reflect.DeepEqual(runners, want) {
+		t.Errorf("Actions.ListHostedRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestActionsService_ListHostedRunnerMachineSpecs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/machine-sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":2,"machine_specs":[
+			{"id":"4-core","cpu_cores":4,"memory_gb":16,"storage_gb":150},
+			{"id":"8-core","cpu_cores":8,"memory_gb":32,"storage_gb":300}
+		]}`)
+	})
+
+	specs, _, err := client.Actions.ListHostedRunnerMachineSpecs(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunnerMachineSpecs returned error: %v", err)
+	}
+
+	want := &HostedRunnerMachineSpecs{
+		TotalCount: 2,
+		MachineSpecs: []*HostedRunnerMachineSpec{
+			{ID: String("4-core"), CPUCores: Int(4), MemoryGB: Int(16), StorageGB: Int(150)},
+			{ID: String("8-core"), CPUCores: Int(8), MemoryGB: Int(32), StorageGB: Int(300)},
+		},
+	}
+	if !reflect.DeepEqual(specs, want) {
+		t.Errorf("Actions.ListHostedRunnerMachineSpecs returned %+v, want %+v", specs, want)
+	}
+}
+
+func TestActionsService_ListHostedRunnerGitHubOwnedImages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/images/github-owned", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"images":[{"id":"ubuntu-22.04","platform":"linux-x64","size_gb":86,"display_name":"22.04","source":"github"}]}`)
+	})
+
+	images, _, err := client.Actions.ListHostedRunnerGitHubOwnedImages(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunnerGitHubOwnedImages returned error: %v", err)
+	}
+
+	want := &HostedRunnerImages{
+		TotalCount: 1,
+		Images: []*HostedRunnerImage{
+			{ID: String("ubuntu-22.04"), Platform: String("linux-x64"), SizeGB: Int64(86), DisplayName: String("22.04"), Source: String("github")},
+		},
+	}
+	if !reflect.DeepEqual(images, want) {
+		t.Errorf("Actions.ListHostedRunnerGitHubOwnedImages returned %+v, want %+v", images, want)
+	}
+}
+
+func TestActionsService_ListHostedRunners_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Actions.ListHostedRunners(context.Background(), "%", nil)
+	testURLParseError(t, err)
+}
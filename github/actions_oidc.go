@@ -0,0 +1,71 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// OIDCSubjectClaimCustomTemplate represents the customization template used
+// to build the subject ("sub") claim of the OpenID Connect tokens issued to
+// GitHub Actions workflows. It is shared by the organization-level methods of
+// OrganizationsService and the repository-level methods of ActionsService.
+type OIDCSubjectClaimCustomTemplate struct {
+	// UseDefault is only used by repositories. When true, the repository
+	// uses the default template of its organization (or GitHub's default)
+	// and IncludeClaimKeys is ignored.
+	UseDefault *bool `json:"use_default,omitempty"`
+
+	// IncludeClaimKeys lists the claims, such as "repo" or "context",
+	// that make up the subject claim, in order.
+	IncludeClaimKeys []string `json:"include_claim_keys"`
+}
+
+// GetRepoOIDCSubjectClaim gets the customization template for the OpenID
+// Connect subject claim of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/oidc#get-the-customization-template-for-an-oidc-subject-claim-for-a-repository
+func (s *ActionsService) GetRepoOIDCSubjectClaim(ctx context.Context, owner, repo string) (*OIDCSubjectClaimCustomTemplate, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/oidc/customization/sub", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmpl := new(OIDCSubjectClaimCustomTemplate)
+	resp, err := s.client.Do(ctx, req, tmpl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tmpl, resp, nil
+}
+
+// SetRepoOIDCSubjectClaim sets the customization template for the OpenID
+// Connect subject claim of a repository. Set template.UseDefault to true
+// to opt the repository back into its organization's template. An empty
+// IncludeClaimKeys list is sent as such.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/oidc#set-the-customization-template-for-an-oidc-subject-claim-for-a-repository
+func (s *ActionsService) SetRepoOIDCSubjectClaim(ctx context.Context, owner, repo string, template *OIDCSubjectClaimCustomTemplate) (*Response, error) {
+	if template == nil {
+		return nil, errors.New("template must be provided")
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/actions/oidc/customization/sub", owner, repo)
+	body := &OIDCSubjectClaimCustomTemplate{UseDefault: template.UseDefault, IncludeClaimKeys: template.IncludeClaimKeys}
+	if body.IncludeClaimKeys == nil {
+		body.IncludeClaimKeys = []string{}
+	}
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
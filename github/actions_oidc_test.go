@@ -0,0 +1,77 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_GetRepoOIDCSubjectClaim(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/oidc/customization/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"use_default":false,"include_claim_keys":["repo","context"]}`)
+	})
+
+	tmpl, _, err := client.Actions.GetRepoOIDCSubjectClaim(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetRepoOIDCSubjectClaim returned error: %v", err)
+	}
+
+	want := &OIDCSubjectClaimCustomTemplate{UseDefault: Bool(false), IncludeClaimKeys: []string{"repo", "context"}}
+	if !reflect.DeepEqual(tmpl, want) {
+		t.Errorf("Actions.GetRepoOIDCSubjectClaim returned %+v, want %+v", tmpl, want)
+	}
+}
+
+func TestActionsService_SetRepoOIDCSubjectClaim(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/oidc/customization/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"use_default":true,"include_claim_keys":[]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &OIDCSubjectClaimCustomTemplate{UseDefault: Bool(true)}
+	_, err := client.Actions.SetRepoOIDCSubjectClaim(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Actions.SetRepoOIDCSubjectClaim returned error: %v", err)
+	}
+}
+
+func TestActionsService_SetRepoOIDCSubjectClaim_claimKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/oidc/customization/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"use_default":false,"include_claim_keys":["repo","context"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &OIDCSubjectClaimCustomTemplate{UseDefault: Bool(false), IncludeClaimKeys: []string{"repo", "context"}}
+	_, err := client.Actions.SetRepoOIDCSubjectClaim(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Actions.SetRepoOIDCSubjectClaim returned error: %v", err)
+	}
+}
+
+func TestActionsService_SetRepoOIDCSubjectClaim_nilTemplate(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if _, err := client.Actions.SetRepoOIDCSubjectClaim(context.Background(), "o", "r", nil); err == nil {
+		t.Error("Actions.SetRepoOIDCSubjectClaim returned no error for a nil template")
+	}
+}
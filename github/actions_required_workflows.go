@@ -0,0 +1,72 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// RepoRequiredWorkflow represents an organization required workflow that
+// applies to a repository.
+type RepoRequiredWorkflow struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Path   *string `json:"path,omitempty"`
+	// State is the state of the required workflow in the repository.
+	// Possible values are: active, deleted.
+	State     *string    `json:"state,omitempty"`
+	URL       *string    `json:"url,omitempty"`
+	HTMLURL   *string    `json:"html_url,omitempty"`
+	BadgeURL  *string    `json:"badge_url,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+	// SourceRepository is the repository the workflow file is stored in.
+	SourceRepository *Repository `json:"source_repository,omitempty"`
+}
+
+// RepoRequiredWorkflows represents a list of the required workflows that
+// apply to a repository.
+type RepoRequiredWorkflows struct {
+	TotalCount        *int                    `json:"total_count,omitempty"`
+	RequiredWorkflows []*RepoRequiredWorkflow `json:"required_workflows,omitempty"`
+}
+
+// ListRepoRequiredWorkflows lists the organization required workflows that
+// apply to a repository.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.9/rest/actions/required-workflows#list-repository-required-workflows
+func (s *ActionsService) ListRepoRequiredWorkflows(ctx context.Context, owner, repo string, opts *ListOptions) (*RepoRequiredWorkflows, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/required_workflows", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflows := new(RepoRequiredWorkflows)
+	resp, err := s.client.Do(ctx, req, workflows)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return workflows, resp, nil
+}
+
+// ListRepoRequiredWorkflowRuns lists the workflow runs of a required workflow
+// in a repository. requiredWorkflowID is the ID of the required workflow as
+// returned by ListRepoRequiredWorkflows.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.9/rest/actions/required-workflows#list-workflow-runs-for-a-required-workflow
+func (s *ActionsService) ListRepoRequiredWorkflowRuns(ctx context.Context, owner, repo string, requiredWorkflowID int64, opts *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/required_workflows/%v/runs", owner, repo, requiredWorkflowID)
+	return s.listWorkflowRuns(ctx, u, opts)
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_ListRepoRequiredWorkflows(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/required_workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"required_workflows":[{"id":30433642,"name":"Required CI","path":".github/workflows/ci.yml","state":"active","created_at":`+referenceTimeStr+`,"source_repository":{"id":1,"full_name":"o/ci"}}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	workflows, _, err := client.Actions.ListRepoRequiredWorkflows(context.Background(), "o", "r", opts)
+	if err != nil {
+		t.Errorf("Actions.ListRepoRequiredWorkflows returned error: %v", err)
+	}
+
+	want := &RepoRequiredWorkflows{
+		TotalCount: Int(1),
+		RequiredWorkflows: []*RepoRequiredWorkflow{
+			{
+				ID:               Int64(30433642),
+				Name:             String("Required CI"),
+				Path:             String(".github/workflows/ci.yml"),
+				State:            String("active"),
+				CreatedAt:        &Timestamp{referenceTime},
+				SourceRepository: &Repository{ID: Int64(1), FullName: String("o/ci")},
+			},
+		},
+	}
+	if !reflect.DeepEqual(workflows, want) {
+		t.Errorf("Actions.ListRepoRequiredWorkflows returned %+v, want %+v", workflows, want)
+	}
+}
+
+func TestActionsService_ListRepoRequiredWorkflowRuns(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/required_workflows/30433642/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"branch": "main", "per_page": "1"})
+		fmt.Fprint(w, `{"total_count":1,"workflow_runs":[{"id":399444496,"status":"completed","conclusion":"success"}]}`)
+	})
+
+	opts := &ListWorkflowRunsOptions{Branch: "main", ListOptions: ListOptions{PerPage: 1}}
+	runs, _, err := client.Actions.ListRepoRequiredWorkflowRuns(context.Background(), "o", "r", 30433642, opts)
+	if err != nil {
+		t.Errorf("Actions.ListRepoRequiredWorkflowRuns returned error: %v", err)
+	}
+
+	want := &WorkflowRuns{
+		TotalCount: Int(1),
+		WorkflowRuns: []*WorkflowRun{
+			{ID: Int64(399444496), Status: String("completed"), Conclusion: String("success")},
+		},
+	}
+	if !reflect.DeepEqual(runs, want) {
+		t.Errorf("Actions.ListRepoRequiredWorkflowRuns returned %+v, want %+v", runs, want)
+	}
+}
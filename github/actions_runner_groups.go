@@ -0,0 +1,108 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// RunnerGroup represents a self-hosted runner group configured in an
+// organization.
+type RunnerGroup struct {
+	ID                       *int64  `json:"id,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+	Visibility               *string `json:"visibility,omitempty"`
+	Default                  *bool   `json:"default,omitempty"`
+	SelectedRepositoriesURL  *string `json:"selected_repositories_url,omitempty"`
+	RunnersURL               *string `json:"runners_url,omitempty"`
+	Inherited                *bool   `json:"inherited,omitempty"`
+	AllowsPublicRepositories *bool   `json:"allows_public_repositories,omitempty"`
+	// RestrictedToWorkflows reports whether the runner group can only be
+	// used by the workflows listed in SelectedWorkflows.
+	RestrictedToWorkflows        *bool    `json:"restricted_to_workflows,omitempty"`
+	SelectedWorkflows            []string `json:"selected_workflows,omitempty"`
+	WorkflowRestrictionsReadOnly *bool    `json:"workflow_restrictions_read_only,omitempty"`
+}
+
+// ListRunnerGroupRepositories lists the repositories with access to a
+// self-hosted runner group configured in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/self-hosted-runner-groups#list-repository-access-to-a-self-hosted-runner-group-in-an-organization
+func (s *ActionsService) ListRunnerGroupRepositories(ctx context.Context, org string, groupID int64, opts *ListOptions) (*SelectedReposList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", org, groupID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repos := new(SelectedReposList)
+	resp, err := s.client.Do(ctx, req, repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
+
+// SetRunnerGroupRepositories replaces the list of repositories with access to
+// a self-hosted runner group configured in an organization. The runner group
+// must have its visibility set to "selected". An empty ids removes access
+// for every repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/self-hosted-runner-groups#set-repository-access-for-a-self-hosted-runner-group-in-an-organization
+func (s *ActionsService) SetRunnerGroupRepositories(ctx context.Context, org string, groupID int64, ids SelectedRepoIDs) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v/repositories", org, groupID)
+
+	if ids == nil {
+		ids = SelectedRepoIDs{}
+	}
+	body := struct {
+		SelectedRepositoryIDs SelectedRepoIDs `json:"selected_repository_ids"`
+	}{ids}
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// SetRunnerGroupAllowedWorkflows restricts a self-hosted runner group
+// configured in an organization to the given workflows, and returns the
+// updated runner group. Workflows are given by path and ref, such as
+// "octo-org/octo-repo/.github/workflows/deploy.yaml@main". An empty
+// workflows lifts the restriction, allowing any workflow to use the group.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/self-hosted-runner-groups#update-a-self-hosted-runner-group-for-an-organization
+func (s *ActionsService) SetRunnerGroupAllowedWorkflows(ctx context.Context, org string, groupID int64, workflows []string) (*RunnerGroup, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/runner-groups/%v", org, groupID)
+
+	if workflows == nil {
+		workflows = []string{}
+	}
+	body := struct {
+		RestrictedToWorkflows bool     `json:"restricted_to_workflows"`
+		SelectedWorkflows     []string `json:"selected_workflows"`
+	}{len(workflows) > 0, workflows}
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(RunnerGroup)
+	resp, err := s.client.Do(ctx, req, group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
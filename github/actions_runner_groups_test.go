@@ -0,0 +1,96 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_ListRunnerGroupRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{"total_count":2,"repositories":[{"id":43}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	repos, _, err := client.Actions.ListRunnerGroupRepositories(context.Background(), "o", 2, opts)
+	if err != nil {
+		t.Errorf("Actions.ListRunnerGroupRepositories returned error: %v", err)
+	}
+
+	want := &SelectedReposList{TotalCount: Int(2), Repositories: []*Repository{{ID: Int64(43)}}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Actions.ListRunnerGroupRepositories returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestActionsService_SetRunnerGroupRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_repository_ids":[43,44]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Actions.SetRunnerGroupRepositories(context.Background(), "o", 2, SelectedRepoIDs{43, 44})
+	if err != nil {
+		t.Errorf("Actions.SetRunnerGroupRepositories returned error: %v", err)
+	}
+}
+
+func TestActionsService_SetRunnerGroupAllowedWorkflows(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"restricted_to_workflows":true,"selected_workflows":["o/r/.github/workflows/deploy.yaml@main"]}`+"\n")
+		fmt.Fprint(w, `{"id":2,"name":"deploy","visibility":"selected","restricted_to_workflows":true,"selected_workflows":["o/r/.github/workflows/deploy.yaml@main"]}`)
+	})
+
+	workflows := []string{"o/r/.github/workflows/deploy.yaml@main"}
+	group, _, err := client.Actions.SetRunnerGroupAllowedWorkflows(context.Background(), "o", 2, workflows)
+	if err != nil {
+		t.Errorf("Actions.SetRunnerGroupAllowedWorkflows returned error: %v", err)
+	}
+
+	want := &RunnerGroup{
+		ID:                    Int64(2),
+		Name:                  String("deploy"),
+		Visibility:            String("selected"),
+		RestrictedToWorkflows: Bool(true),
+		SelectedWorkflows:     workflows,
+	}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Actions.SetRunnerGroupAllowedWorkflows returned %+v, want %+v", group, want)
+	}
+}
+
+func TestActionsService_SetRunnerGroupAllowedWorkflows_unrestrict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"restricted_to_workflows":false,"selected_workflows":[]}`+"\n")
+		fmt.Fprint(w, `{"id":2,"restricted_to_workflows":false}`)
+	})
+
+	_, _, err := client.Actions.SetRunnerGroupAllowedWorkflows(context.Background(), "o", 2, nil)
+	if err != nil {
+		t.Errorf("Actions.SetRunnerGroupAllowedWorkflows returned error: %v", err)
+	}
+}
@@ -262,6 +262,22 @@ func TestActionsService_SetSelectedReposForOrgSecret(t *testing.T) {
 	}
 }
 
+func TestActionsService_SetSelectedReposForOrgSecret_empty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_repository_ids":[]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Actions.SetSelectedReposForOrgSecret(context.Background(), "o", "NAME", nil)
+	if err != nil {
+		t.Errorf("Actions.SetSelectedReposForOrgSecret returned error: %v", err)
+	}
+}
+
 func TestActionsService_AddSelectedRepoToOrgSecret(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -305,3 +321,110 @@ func TestActionsService_DeleteOrgSecret(t *testing.T) {
 		t.Errorf("Actions.DeleteOrgSecret returned error: %v", err)
 	}
 }
+
+func TestActionsService_GetEnvPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	key, _, err := client.Actions.GetEnvPublicKey(context.Background(), 1, "e")
+	if err != nil {
+		t.Errorf("Actions.GetEnvPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Actions.GetEnvPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestActionsService_ListEnvSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories/1/environments/e/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":4,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"},{"name":"B","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 2}
+	secrets, _, err := client.Actions.ListEnvSecrets(context.Background(), 1, "e", opts)
+	if err != nil {
+		t.Errorf("Actions.ListEnvSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 4,
+		Secrets: []*Secret{
+			{Name: "A", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+			{Name: "B", CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)}, UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)}},
+		},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Actions.ListEnvSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestActionsService_EnvSecretRoundTrip(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	stored := map[string]string{} // secret name to encrypted value
+	mux.HandleFunc("/repositories/1/environments/e/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			testHeader(t, r, "Content-Type", "application/json")
+			testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+			stored["NAME"] = "QIv="
+			w.WriteHeader(http.StatusCreated)
+		case "GET":
+			if _, ok := stored["NAME"]; !ok {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprint(w, `{"name":"NAME","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}`)
+		case "DELETE":
+			delete(stored, "NAME")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	input := &EncryptedSecret{
+		Name:           "NAME",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	if _, err := client.Actions.CreateOrUpdateEnvSecret(ctx, 1, "e", input); err != nil {
+		t.Fatalf("Actions.CreateOrUpdateEnvSecret returned error: %v", err)
+	}
+
+	secret, _, err := client.Actions.GetEnvSecret(ctx, 1, "e", "NAME")
+	if err != nil {
+		t.Fatalf("Actions.GetEnvSecret returned error: %v", err)
+	}
+	want := &Secret{
+		Name:      "NAME",
+		CreatedAt: Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+		UpdatedAt: Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Actions.GetEnvSecret returned %+v, want %+v", secret, want)
+	}
+
+	if _, err := client.Actions.DeleteEnvSecret(ctx, 1, "e", "NAME"); err != nil {
+		t.Fatalf("Actions.DeleteEnvSecret returned error: %v", err)
+	}
+
+	_, resp, err := client.Actions.GetEnvSecret(ctx, 1, "e", "NAME")
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Actions.GetEnvSecret after delete returned %v, want 404", err)
+	}
+}
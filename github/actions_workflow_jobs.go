@@ -38,6 +38,18 @@ type WorkflowJob struct {
 	Name        *string     `json:"name,omitempty"`
 	Steps       []*TaskStep `json:"steps,omitempty"`
 	CheckRunURL *string     `json:"check_run_url,omitempty"`
+	// RunAttempt is the attempt of the workflow run the job belongs to.
+	// Jobs from earlier attempts are listed by ListWorkflowJobs when the
+	// "all" filter is used.
+	RunAttempt *int64 `json:"run_attempt,omitempty"`
+	// Labels are the labels of the runner the job requested with runs-on.
+	Labels []string `json:"labels,omitempty"`
+	// RunnerID, RunnerName, RunnerGroupID and RunnerGroupName identify the
+	// runner that ran the job. They are not set while the job is queued.
+	RunnerID        *int64  `json:"runner_id,omitempty"`
+	RunnerName      *string `json:"runner_name,omitempty"`
+	RunnerGroupID   *int64  `json:"runner_group_id,omitempty"`
+	RunnerGroupName *string `json:"runner_group_name,omitempty"`
 }
 
 // Jobs represents a slice of repository action workflow job.
@@ -71,6 +71,72 @@ func TestActionsService_ListWorkflowJobs_Filter(t *testing.T) {
 	}
 }
 
+func TestActionsService_ListWorkflowJobs_allAttempts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"filter": "all"})
+		fmt.Fprint(w, `{"total_count":2,"jobs":[
+			{"id":2,"run_id":29679449,"run_attempt":2,"conclusion":"success","labels":["ubuntu-latest"],
+			 "runner_id":7,"runner_name":"GitHub Actions 7","runner_group_id":1,"runner_group_name":"GitHub Actions",
+			 "steps":[{"name":"Build","number":1,"status":"completed","conclusion":"success","started_at":"2021-01-02T15:04:05Z","completed_at":"2021-01-02T15:05:05Z"}]},
+			{"id":1,"run_id":29679449,"run_attempt":1,"conclusion":"failure","labels":["ubuntu-latest"],
+			 "steps":[{"name":"Build","number":1,"status":"completed","conclusion":"failure","started_at":"2021-01-02T15:00:05Z","completed_at":"2021-01-02T15:02:05Z"}]}
+		]}`)
+	})
+
+	opts := &ListWorkflowJobsOptions{Filter: "all"}
+	jobs, _, err := client.Actions.ListWorkflowJobs(context.Background(), "o", "r", 29679449, opts)
+	if err != nil {
+		t.Errorf("Actions.ListWorkflowJobs returned error: %v", err)
+	}
+
+	want := &Jobs{
+		TotalCount: Int(2),
+		Jobs: []*WorkflowJob{
+			{
+				ID:              Int64(2),
+				RunID:           Int64(29679449),
+				RunAttempt:      Int64(2),
+				Conclusion:      String("success"),
+				Labels:          []string{"ubuntu-latest"},
+				RunnerID:        Int64(7),
+				RunnerName:      String("GitHub Actions 7"),
+				RunnerGroupID:   Int64(1),
+				RunnerGroupName: String("GitHub Actions"),
+				Steps: []*TaskStep{{
+					Name:        String("Build"),
+					Number:      Int64(1),
+					Status:      String("completed"),
+					Conclusion:  String("success"),
+					StartedAt:   &Timestamp{time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)},
+					CompletedAt: &Timestamp{time.Date(2021, time.January, 2, 15, 5, 5, 0, time.UTC)},
+				}},
+			},
+			{
+				ID:         Int64(1),
+				RunID:      Int64(29679449),
+				RunAttempt: Int64(1),
+				Conclusion: String("failure"),
+				Labels:     []string{"ubuntu-latest"},
+				Steps: []*TaskStep{{
+					Name:        String("Build"),
+					Number:      Int64(1),
+					Status:      String("completed"),
+					Conclusion:  String("failure"),
+					StartedAt:   &Timestamp{time.Date(2021, time.January, 2, 15, 0, 5, 0, time.UTC)},
+					CompletedAt: &Timestamp{time.Date(2021, time.January, 2, 15, 2, 5, 0, time.UTC)},
+				}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(jobs, want) {
+		t.Errorf("Actions.ListWorkflowJobs returned %+v, want %+v", jobs, want)
+	}
+}
+
 func TestActionsService_GetWorkflowJobByID(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -6,8 +6,12 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 )
@@ -52,6 +56,9 @@ type ListWorkflowRunsOptions struct {
 	Branch string `url:"branch,omitempty"`
 	Event  string `url:"event,omitempty"`
 	Status string `url:"status,omitempty"`
+	// Created restricts the results to workflow runs created within the range.
+	Created TimeRange `url:"created,omitempty"`
+
 	ListOptions
 }
 
@@ -110,6 +117,43 @@ func (s *ActionsService) ListWorkflowRunsByFileName(ctx context.Context, owner,
 	return s.listWorkflowRuns(ctx, u, opts)
 }
 
+// ListWorkflowRunsByFileNameAll lists the workflow runs of the workflow with
+// the given file name, following pagination until every page has been
+// fetched. opts.Page is used as the first page to fetch. If conclusion is not
+// empty, only the runs with that conclusion, such as success, failure or
+// cancelled, are returned.
+//
+// Since the conclusion is filtered client-side, every run matching the
+// other options is fetched, one page per request against the core rate
+// limit, regardless of how few runs match. For workflows with a long
+// history, set opts.PerPage to 100 (the maximum) and narrow the runs with
+// opts.Created or opts.Branch. GitHub returns at most 1,000 runs when the
+// runs are filtered by actor, branch, event, status or created.
+//
+// If a request fails, the matching runs fetched so far are returned along
+// with the error.
+func (s *ActionsService) ListWorkflowRunsByFileNameAll(ctx context.Context, owner, repo, workflowFileName, conclusion string, opts *ListWorkflowRunsOptions) ([]*WorkflowRun, *Response, error) {
+	o := new(ListWorkflowRunsOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*WorkflowRun
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		runs, resp, err := s.ListWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, o)
+		if err != nil {
+			return resp, err
+		}
+		for _, run := range runs.WorkflowRuns {
+			if conclusion == "" || run.GetConclusion() == conclusion {
+				all = append(all, run)
+			}
+		}
+		return resp, nil
+	})
+	return all, resp, err
+}
+
 // ListRepositoryWorkflowRuns lists all workflow runs for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#list-workflow-runs-for-a-repository
@@ -200,6 +244,124 @@ func (s *ActionsService) GetWorkflowRunLogs(ctx context.Context, owner, repo str
 	return parsedURL, newResponse(resp), err
 }
 
+// WorkflowRunLogsPurgedError is returned when the logs of a workflow run are
+// no longer available, because they expired or were deleted.
+type WorkflowRunLogsPurgedError struct {
+	RunID int64
+
+	// ErrorResponse is the underlying 410 response returned by GitHub.
+	ErrorResponse *ErrorResponse
+}
+
+func (e *WorkflowRunLogsPurgedError) Error() string {
+	return fmt.Sprintf("logs of workflow run %v are no longer available: %v", e.RunID, e.ErrorResponse)
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *WorkflowRunLogsPurgedError) Unwrap() error { return e.ErrorResponse }
+
+// GetWorkflowRunLogsStream downloads the logs of a workflow run from the
+// location returned by GetWorkflowRunLogs. The returned ReadCloser streams
+// the zip archive of the logs, and must be closed by the caller. The archive
+// is fetched with http.DefaultClient, so that the credentials of the client
+// are not sent to the storage host GitHub redirects to.
+//
+// If the logs are no longer available, a *WorkflowRunLogsPurgedError is
+// returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#download-workflow-run-logs
+func (s *ActionsService) GetWorkflowRunLogsStream(ctx context.Context, owner, repo string, runID int64) (io.ReadCloser, *Response, error) {
+	logsURL, resp, err := s.GetWorkflowRunLogs(ctx, owner, repo, runID, false)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusGone {
+			err = &WorkflowRunLogsPurgedError{RunID: runID, ErrorResponse: &ErrorResponse{Response: resp.Response}}
+		}
+		return nil, resp, err
+	}
+
+	body, err := downloadFromStorage(ctx, logsURL)
+	if err != nil {
+		return nil, resp, err
+	}
+	return body, resp, nil
+}
+
+// downloadFromStorage fetches u, the storage location GitHub redirects a
+// download to, with http.DefaultClient rather than the client's own
+// transport, so that no GitHub credentials are sent to the storage host. The
+// returned body must be closed by the caller.
+func downloadFromStorage(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = withContext(ctx, req)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// maxWorkflowRunLogsSize is the size of the largest logs archive
+// ExtractWorkflowRunLogs reads into memory.
+const maxWorkflowRunLogsSize = 512 << 20
+
+// ExtractWorkflowRunLogs downloads the logs of a workflow run and calls fn
+// for each log file in the archive.
+// name is the path of the file within the archive: the full log of each job
+// is at the top level (for example "0_build.txt"), and the log of each step
+// is in a directory named after its job (for example "build/1_Set up job.txt").
+// Since reading a zip archive requires random access, the archive is read
+// into memory, and an error is returned if it is larger than 512 MiB. If fn
+// returns an error, the remaining files are skipped and that error is
+// returned.
+//
+// If the logs are no longer available, a *WorkflowRunLogsPurgedError is
+// returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#download-workflow-run-logs
+func (s *ActionsService) ExtractWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64, fn func(name string, r io.Reader) error) (*Response, error) {
+	body, resp, err := s.GetWorkflowRunLogsStream(ctx, owner, repo, runID)
+	if err != nil {
+		return resp, err
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxWorkflowRunLogsSize+1))
+	if err != nil {
+		return resp, err
+	}
+	if len(data) > maxWorkflowRunLogsSize {
+		return resp, fmt.Errorf("logs archive of workflow run %v is larger than %v bytes", runID, maxWorkflowRunLogsSize)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return resp, err
+	}
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return resp, err
+		}
+		err = fn(zf.Name, rc)
+		rc.Close()
+		if err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
 // DeleteWorkflowRunLogs deletes all logs for a workflow run.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/actions/#delete-workflow-run-logs
@@ -6,8 +6,13 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -71,6 +76,50 @@ func TestActionsService_ListWorkflowRunsFileName(t *testing.T) {
 	}
 }
 
+func TestActionsService_ListWorkflowRunsByFileNameAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/ci.yml/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("conclusion"); got != "" {
+			t.Errorf("conclusion sent to the API: %q", got)
+		}
+		switch page := r.FormValue("page"); page {
+		case "":
+			testFormValues(t, r, values{"branch": "main", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/actions/workflows/ci.yml/runs?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":4,"workflow_runs":[{"id":1,"conclusion":"success"},{"id":2,"conclusion":"failure"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":4,"workflow_runs":[{"id":3,"conclusion":"cancelled"},{"id":4,"conclusion":"failure"}]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &ListWorkflowRunsOptions{Branch: "main", ListOptions: ListOptions{PerPage: 100}}
+	runs, _, err := client.Actions.ListWorkflowRunsByFileNameAll(context.Background(), "o", "r", "ci.yml", "failure", opts)
+	if err != nil {
+		t.Errorf("Actions.ListWorkflowRunsByFileNameAll returned error: %v", err)
+	}
+
+	want := []*WorkflowRun{
+		{ID: Int64(2), Conclusion: String("failure")},
+		{ID: Int64(4), Conclusion: String("failure")},
+	}
+	if !reflect.DeepEqual(runs, want) {
+		t.Errorf("Actions.ListWorkflowRunsByFileNameAll returned %+v, want %+v", runs, want)
+	}
+
+	runs, _, err = client.Actions.ListWorkflowRunsByFileNameAll(context.Background(), "o", "r", "ci.yml", "", &ListWorkflowRunsOptions{Branch: "main", ListOptions: ListOptions{PerPage: 100}})
+	if err != nil {
+		t.Errorf("Actions.ListWorkflowRunsByFileNameAll returned error: %v", err)
+	}
+	if len(runs) != 4 {
+		t.Errorf("Actions.ListWorkflowRunsByFileNameAll returned %v runs without a conclusion filter, want 4", len(runs))
+	}
+}
+
 func TestActionsService_GetWorkflowRunByID(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -287,3 +336,87 @@ func TestActionsService_GetWorkflowRunUsageByID(t *testing.T) {
 		t.Errorf("Actions.GetWorkflowRunUsageByID returned %+v, want %+v", workflowRunUsage, want)
 	}
 }
+
+// zipArchive returns a zip archive holding the given files.
+func zipArchive(t *testing.T, files map[string]string, names ...string) []byte {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for _, name := range names {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip.Writer.Create returned error: %v", err)
+		}
+		io.WriteString(w, files[name])
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip.Writer.Close returned error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestActionsService_ExtractWorkflowRunLogs(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client = client.WithTokenSource(StaticTokenSource("t"))
+
+	files := map[string]string{
+		"0_build.txt":            "full build log",
+		"build/":                 "",
+		"build/1_Set up job.txt": "set up",
+	}
+	archive := zipArchive(t, files, "0_build.txt", "build/", "build/1_Set up job.txt")
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "token t")
+		http.Redirect(w, r, serverURL+baseURLPath+"/blob/logs.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/blob/logs.zip", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("storage request sent Authorization header %q", auth)
+		}
+		w.Write(archive)
+	})
+
+	got := map[string]string{}
+	_, err := client.Actions.ExtractWorkflowRunLogs(context.Background(), "o", "r", 399444496, func(name string, r io.Reader) error {
+		b, err := ioutil.ReadAll(r)
+		got[name] = string(b)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Actions.ExtractWorkflowRunLogs returned error: %v", err)
+	}
+
+	want := map[string]string{
+		"0_build.txt":            "full build log",
+		"build/1_Set up job.txt": "set up",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Actions.ExtractWorkflowRunLogs extracted %v, want %v", got, want)
+	}
+}
+
+func TestActionsService_GetWorkflowRunLogsStream_purged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusGone)
+		fmt.Fprint(w, `{"message":"Logs have been deleted"}`)
+	})
+
+	_, resp, err := client.Actions.GetWorkflowRunLogsStream(context.Background(), "o", "r", 399444496)
+	var purged *WorkflowRunLogsPurgedError
+	if !errors.As(err, &purged) {
+		t.Fatalf("Actions.GetWorkflowRunLogsStream returned error %#v, want *WorkflowRunLogsPurgedError", err)
+	}
+	if purged.RunID != 399444496 {
+		t.Errorf("WorkflowRunLogsPurgedError.RunID is %v, want %v", purged.RunID, 399444496)
+	}
+	if resp.StatusCode != http.StatusGone {
+		t.Errorf("Actions.GetWorkflowRunLogsStream returned status %v, want %v", resp.StatusCode, http.StatusGone)
+	}
+}
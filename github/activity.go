@@ -28,14 +28,22 @@ type Feeds struct {
 	CurrentUserActorURL         *string  `json:"current_user_actor_url,omitempty"`
 	CurrentUserOrganizationURL  *string  `json:"current_user_organization_url,omitempty"`
 	CurrentUserOrganizationURLs []string `json:"current_user_organization_urls,omitempty"`
-	Links                       *struct {
-		Timeline                 *FeedLink   `json:"timeline,omitempty"`
-		User                     *FeedLink   `json:"user,omitempty"`
-		CurrentUserPublic        *FeedLink   `json:"current_user_public,omitempty"`
-		CurrentUser              *FeedLink   `json:"current_user,omitempty"`
-		CurrentUserActor         *FeedLink   `json:"current_user_actor,omitempty"`
-		CurrentUserOrganization  *FeedLink   `json:"current_user_organization,omitempty"`
-		CurrentUserOrganizations []*FeedLink `json:"current_user_organizations,omitempty"`
+	SecurityAdvisoriesURL       *string  `json:"security_advisories_url,omitempty"`
+	// RepositoryDiscussionsURL and RepositoryDiscussionsCategoryURL are
+	// URI templates, expanded with a repository and a discussion category.
+	RepositoryDiscussionsURL         *string `json:"repository_discussions_url,omitempty"`
+	RepositoryDiscussionsCategoryURL *string `json:"repository_discussions_category_url,omitempty"`
+	Links                            *struct {
+		Timeline                      *FeedLink   `json:"timeline,omitempty"`
+		User                          *FeedLink   `json:"user,omitempty"`
+		CurrentUserPublic             *FeedLink   `json:"current_user_public,omitempty"`
+		CurrentUser                   *FeedLink   `json:"current_user,omitempty"`
+		CurrentUserActor              *FeedLink   `json:"current_user_actor,omitempty"`
+		CurrentUserOrganization       *FeedLink   `json:"current_user_organization,omitempty"`
+		CurrentUserOrganizations      []*FeedLink `json:"current_user_organizations,omitempty"`
+		SecurityAdvisories            *FeedLink   `json:"security_advisories,omitempty"`
+		RepositoryDiscussions         *FeedLink   `json:"repository_discussions,omitempty"`
+		RepositoryDiscussionsCategory *FeedLink   `json:"repository_discussions_category,omitempty"`
 	} `json:"_links,omitempty"`
 }
 
@@ -50,9 +58,14 @@ type Feeds struct {
 //         authenticated user
 //     Current user organizations: The private timeline for the organizations
 //         the authenticated user is a member of.
+//     Security advisories: The security advisories for GitHub
+//     Repository discussions: The discussions of any repository, using URI
+//         template, optionally limited to a discussion category
 //
 // Note: Private feeds are only returned when authenticating via Basic Auth
-// since current feed URIs use the older, non revocable auth tokens.
+// since current feed URIs use the older, non revocable auth tokens. The
+// URLs of private feeds embed the token that gives access to them, and
+// should be treated as secrets.
 func (s *ActivityService) ListFeeds(ctx context.Context) (*Feeds, *Response, error) {
 	req, err := s.client.NewRequest("GET", "feeds", nil)
 	if err != nil {
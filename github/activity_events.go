@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 )
 
@@ -57,6 +58,36 @@ func (s *ActivityService) ListRepositoryEvents(ctx context.Context, owner, repo
 	return events, resp, nil
 }
 
+// StreamRepositoryEvents is like ListRepositoryEvents, but decodes the
+// events of the requested page one at a time and passes each of them to fn
+// instead of returning them all at once, so that memory use does not grow
+// with the size of the page. If fn returns an error, the rest of the page is
+// skipped and that error is returned.
+//
+// Use the returned Response to fetch further pages.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-repository-events
+func (s *ActivityService) StreamRepositoryEvents(ctx context.Context, owner, repo string, opts *ListOptions, fn func(*Event) error) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/events", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, jsonArrayStream(func(dec *json.Decoder) error {
+		event := new(Event)
+		if err := dec.Decode(event); err != nil {
+			return err
+		}
+		return fn(event)
+	}))
+}
+
 // ListIssueEventsForRepository lists issue events for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#list-issue-events-for-a-repository
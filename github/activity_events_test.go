@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -70,6 +71,57 @@ func TestActivityService_ListRepositoryEvents_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestActivityService_StreamRepositoryEvents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=3>; rel="next"`)
+		fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
+	})
+
+	var ids []string
+	opt := &ListOptions{Page: 2}
+	resp, err := client.Activity.StreamRepositoryEvents(context.Background(), "o", "r", opt, func(e *Event) error {
+		ids = append(ids, e.GetID())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Activity.StreamRepositoryEvents returned error: %v", err)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Activity.StreamRepositoryEvents streamed %v, want %v", ids, want)
+	}
+	if got, want := resp.NextPage, 3; got != want {
+		t.Errorf("Activity.StreamRepositoryEvents NextPage is %v, want %v", got, want)
+	}
+}
+
+func TestActivityService_StreamRepositoryEvents_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"1"},{"id":"2"}]`)
+	})
+
+	stop := errors.New("stop")
+	calls := 0
+	_, err := client.Activity.StreamRepositoryEvents(context.Background(), "o", "r", nil, func(e *Event) error {
+		calls++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Activity.StreamRepositoryEvents returned error %v, want %v", err, stop)
+	}
+	if calls != 1 {
+		t.Errorf("Activity.StreamRepositoryEvents called fn %v times, want 1", calls)
+	}
+}
+
 func TestActivityService_ListIssueEventsForRepository(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
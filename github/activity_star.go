@@ -99,6 +99,28 @@ func (s *ActivityService) ListStarred(ctx context.Context, user string, opts *Ac
 	return repos, resp, nil
 }
 
+// ListStarredAll lists all the repos starred by a user, following pagination
+// until every page has been fetched. Passing the empty string will list the
+// starred repositories for the authenticated user. opts.Page is used as the
+// first page to fetch.
+//
+// If a request fails, the repositories fetched so far are returned along
+// with the error.
+func (s *ActivityService) ListStarredAll(ctx context.Context, user string, opts *ActivityListStarredOptions) ([]*StarredRepository, *Response, error) {
+	o := new(ActivityListStarredOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*StarredRepository
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		repos, resp, err := s.ListStarred(ctx, user, o)
+		all = append(all, repos...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // IsStarred checks if a repository is starred by authenticated user.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#check-if-a-repository-is-starred-by-the-authenticated-user
@@ -113,6 +135,35 @@ func (s *ActivityService) IsStarred(ctx context.Context, owner, repo string) (bo
 	return starred, resp, err
 }
 
+// AreStarred checks which of the given repositories are starred by the
+// authenticated user. Repositories are identified by their full name, in the
+// form "owner/repo", which is also used as the key of the returned map.
+//
+// One IsStarred request is made per repository, with a bounded number of
+// requests in flight at once, so each repository counts against the rate
+// limit. If any of the checks fail, the results of the successful checks are
+// returned along with a *BatchError describing the failures.
+func (s *ActivityService) AreStarred(ctx context.Context, fullNames []string) (map[string]bool, error) {
+	starred := make([]bool, len(fullNames))
+	err := forEachConcurrently(ctx, fullNames, func(i int) error {
+		owner, repo, err := splitFullName(fullNames[i])
+		if err != nil {
+			return err
+		}
+		starred[i], _, err = s.IsStarred(ctx, owner, repo)
+		return err
+	})
+
+	result := make(map[string]bool, len(fullNames))
+	for i, name := range fullNames {
+		if be, ok := err.(*BatchError); ok && be.Errors[name] != nil {
+			continue
+		}
+		result[name] = starred[i]
+	}
+	return result, err
+}
+
 // Star a repository as the authenticated user.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#star-a-repository-for-the-authenticated-user
@@ -96,6 +96,40 @@ func TestActivityService_ListStarred_invalidUser(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestActivityService_ListStarredAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			testFormValues(t, r, values{"sort": "created"})
+			w.Header().Set("Link", `<https://api.github.com/users/u/starred?sort=created&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"repo":{"id":1}}]`)
+		case "2":
+			testFormValues(t, r, values{"sort": "created", "page": "2"})
+			fmt.Fprint(w, `[{"repo":{"id":2}}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &ActivityListStarredOptions{Sort: "created"}
+	repos, _, err := client.Activity.ListStarredAll(context.Background(), "u", opts)
+	if err != nil {
+		t.Errorf("Activity.ListStarredAll returned error: %v", err)
+	}
+
+	want := []*StarredRepository{{Repository: &Repository{ID: Int64(1)}}, {Repository: &Repository{ID: Int64(2)}}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Activity.ListStarredAll returned %+v, want %+v", repos, want)
+	}
+	if opts.Page != 0 {
+		t.Errorf("Activity.ListStarredAll modified opts.Page to %v", opts.Page)
+	}
+}
+
 func TestActivityService_IsStarred_hasStar(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -140,6 +174,38 @@ func TestActivityService_IsStarred_invalidID(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestActivityService_AreStarred(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred/o/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/user/starred/o/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/user/starred/o/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	starred, err := client.Activity.AreStarred(context.Background(), []string{"o/a", "o/b", "o/c", "invalid"})
+	be, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Activity.AreStarred returned error %v, want *BatchError", err)
+	}
+	if len(be.Errors) != 2 || be.Errors["o/c"] == nil || be.Errors["invalid"] == nil {
+		t.Errorf("Activity.AreStarred returned errors %v, want errors for o/c and invalid", be.Errors)
+	}
+
+	want := map[string]bool{"o/a": true, "o/b": false}
+	if !reflect.DeepEqual(starred, want) {
+		t.Errorf("Activity.AreStarred returned %+v, want %+v", starred, want)
+	}
+}
+
 func TestActivityService_Star(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -71,6 +71,9 @@ var feedsJSON = []byte(`{
   "current_user_organization_urls": [
     "https://github.com/organizations/github/defunkt.private.atom?token=abc123"
   ],
+  "security_advisories_url": "https://github.com/security-advisories",
+  "repository_discussions_url": "https://github.com/{user}/{repo}/discussions",
+  "repository_discussions_category_url": "https://github.com/{user}/{repo}/discussions/categories/{category}",
   "_links": {
     "timeline": {
       "href": "https://github.com/timeline",
@@ -101,7 +104,19 @@ var feedsJSON = []byte(`{
         "href": "https://github.com/organizations/github/defunkt.private.atom?token=abc123",
         "type": "application/atom+xml"
       }
-    ]
+    ],
+    "security_advisories": {
+      "href": "https://github.com/security-advisories",
+      "type": "application/atom+xml"
+    },
+    "repository_discussions": {
+      "href": "https://github.com/{user}/{repo}/discussions",
+      "type": "application/atom+xml"
+    },
+    "repository_discussions_category": {
+      "href": "https://github.com/{user}/{repo}/discussions/categories/{category}",
+      "type": "application/atom+xml"
+    }
   }
 }`)
 
@@ -115,14 +130,20 @@ var wantFeeds = &Feeds{
 	CurrentUserOrganizationURLs: []string{
 		"https://github.com/organizations/github/defunkt.private.atom?token=abc123",
 	},
+	SecurityAdvisoriesURL:            String("https://github.com/security-advisories"),
+	RepositoryDiscussionsURL:         String("https://github.com/{user}/{repo}/discussions"),
+	RepositoryDiscussionsCategoryURL: String("https://github.com/{user}/{repo}/discussions/categories/{category}"),
 	Links: &struct {
-		Timeline                 *FeedLink   `json:"timeline,omitempty"`
-		User                     *FeedLink   `json:"user,omitempty"`
-		CurrentUserPublic        *FeedLink   `json:"current_user_public,omitempty"`
-		CurrentUser              *FeedLink   `json:"current_user,omitempty"`
-		CurrentUserActor         *FeedLink   `json:"current_user_actor,omitempty"`
-		CurrentUserOrganization  *FeedLink   `json:"current_user_organization,omitempty"`
-		CurrentUserOrganizations []*FeedLink `json:"current_user_organizations,omitempty"`
+		Timeline                      *FeedLink   `json:"timeline,omitempty"`
+		User                          *FeedLink   `json:"user,omitempty"`
+		CurrentUserPublic             *FeedLink   `json:"current_user_public,omitempty"`
+		CurrentUser                   *FeedLink   `json:"current_user,omitempty"`
+		CurrentUserActor              *FeedLink   `json:"current_user_actor,omitempty"`
+		CurrentUserOrganization       *FeedLink   `json:"current_user_organization,omitempty"`
+		CurrentUserOrganizations      []*FeedLink `json:"current_user_organizations,omitempty"`
+		SecurityAdvisories            *FeedLink   `json:"security_advisories,omitempty"`
+		RepositoryDiscussions         *FeedLink   `json:"repository_discussions,omitempty"`
+		RepositoryDiscussionsCategory *FeedLink   `json:"repository_discussions_category,omitempty"`
 	}{
 		Timeline: &FeedLink{
 			HRef: String("https://github.com/timeline"),
@@ -154,5 +175,17 @@ var wantFeeds = &Feeds{
 				Type: String("application/atom+xml"),
 			},
 		},
+		SecurityAdvisories: &FeedLink{
+			HRef: String("https://github.com/security-advisories"),
+			Type: String("application/atom+xml"),
+		},
+		RepositoryDiscussions: &FeedLink{
+			HRef: String("https://github.com/{user}/{repo}/discussions"),
+			Type: String("application/atom+xml"),
+		},
+		RepositoryDiscussionsCategory: &FeedLink{
+			HRef: String("https://github.com/{user}/{repo}/discussions/categories/{category}"),
+			Type: String("application/atom+xml"),
+		},
 	},
 }
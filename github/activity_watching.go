@@ -80,6 +80,67 @@ func (s *ActivityService) ListWatched(ctx context.Context, user string, opts *Li
 	return watched, resp, nil
 }
 
+// ListWatchedAll lists all the repositories the specified user is watching,
+// following pagination until every page has been fetched. Passing the empty
+// string will list the watched repos of the authenticated user. opts.Page is
+// used as the first page to fetch.
+//
+// If a request fails, the repositories fetched so far are returned along
+// with the error.
+func (s *ActivityService) ListWatchedAll(ctx context.Context, user string, opts *ListOptions) ([]*Repository, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Repository
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		repos, resp, err := s.ListWatched(ctx, user, o)
+		all = append(all, repos...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
+// CopyWatchState makes the user authenticated by toClient watch every
+// repository fromUser is watching, for instance when migrating to a new
+// account. Passing the empty string as fromUser copies the watched repos of
+// the user s is authenticated as; listing another user's watched repos only
+// returns public repositories.
+//
+// One SetRepositorySubscription request is made with toClient per watched
+// repository, with a bounded number of requests in flight at once. The full
+// names of the repositories now watched are returned. If listing fails, its
+// error is returned; if some repositories can't be watched (for example
+// because toClient has no access to them), a *BatchError keyed by full name
+// describes the failures.
+func (s *ActivityService) CopyWatchState(ctx context.Context, fromUser string, toClient *Client) ([]string, error) {
+	repos, _, err := s.ListWatchedAll(ctx, fromUser, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = repo.GetFullName()
+	}
+	watched := make([]bool, len(repos))
+	err = forEachConcurrently(ctx, names, func(i int) error {
+		subscription := &Subscription{Subscribed: Bool(true)}
+		_, _, err := toClient.Activity.SetRepositorySubscription(ctx, repos[i].GetOwner().GetLogin(), repos[i].GetName(), subscription)
+		watched[i] = err == nil
+		return err
+	})
+
+	var copied []string
+	for i, name := range names {
+		if watched[i] {
+			copied = append(copied, name)
+		}
+	}
+	return copied, err
+}
+
 // GetRepositorySubscription returns the subscription for the specified
 // repository for the authenticated user. If the authenticated user is not
 // watching the repository, a nil Subscription is returned.
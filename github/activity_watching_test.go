@@ -84,6 +84,70 @@ func TestActivityService_ListWatched_specifiedUser(t *testing.T) {
 	}
 }
 
+func TestActivityService_ListWatchedAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/users/u/subscriptions?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &ListOptions{PerPage: 1}
+	repos, _, err := client.Activity.ListWatchedAll(context.Background(), "u", opts)
+	if err != nil {
+		t.Errorf("Activity.ListWatchedAll returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Activity.ListWatchedAll returned %+v, want %+v", repos, want)
+	}
+	if opts.Page != 0 {
+		t.Errorf("Activity.ListWatchedAll modified opts.Page to %v", opts.Page)
+	}
+}
+
+func TestActivityService_CopyWatchState(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"name":"a","full_name":"o/a","owner":{"login":"o"}},
+			{"name":"b","full_name":"o/b","owner":{"login":"o"}}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/a/subscription", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"subscribed":true}`+"\n")
+		fmt.Fprint(w, `{"subscribed":true}`)
+	})
+	mux.HandleFunc("/repos/o/b/subscription", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	copied, err := client.Activity.CopyWatchState(context.Background(), "u", client)
+	if want := []string{"o/a"}; !reflect.DeepEqual(copied, want) {
+		t.Errorf("Activity.CopyWatchState returned %v, want %v", copied, want)
+	}
+	be, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Activity.CopyWatchState returned error %#v, want *BatchError", err)
+	}
+	if len(be.Errors) != 1 || be.Errors["o/b"] == nil {
+		t.Errorf("BatchError.Errors = %v, want an error for o/b", be.Errors)
+	}
+}
+
 func TestActivityService_GetRepositorySubscription_true(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -272,6 +272,52 @@ func (s *AppsService) CreateInstallationToken(ctx context.Context, id int64, opt
 	return t, resp, nil
 }
 
+// NewInstallationClient returns a client authenticated as the given
+// installation of the app, for acting on the accounts and repositories the
+// installation has access to. s must be authenticated as the app itself,
+// typically with a JWT.
+//
+// Installation tokens are minted with CreateInstallationToken and cached per
+// installation ID on the app client, so clients returned for the same
+// installation share one token, which is renewed shortly before it expires.
+// The first token is minted using ctx before NewInstallationClient returns;
+// renewals use the context of the request that needs them.
+//
+// The returned client is a copy of the app client (see WithTokenSource), so
+// it sends its requests through the same transport, carrying the same proxy
+// and TLS settings. If the app client was itself created with
+// WithTokenSource, the transport beneath its token source is used instead,
+// so that the app's token is not sent along with the installation's.
+func (s *AppsService) NewInstallationClient(ctx context.Context, installationID int64) (*Client, error) {
+	c := s.client
+	c.installationMu.Lock()
+	src, ok := c.installationTokens[installationID]
+	if !ok {
+		src = RefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+			t, _, err := s.CreateInstallationToken(ctx, installationID, nil)
+			if err != nil {
+				return "", time.Time{}, fmt.Errorf("creating token for installation %v: %w", installationID, err)
+			}
+			return t.GetToken(), t.GetExpiresAt(), nil
+		})
+		if c.installationTokens == nil {
+			c.installationTokens = make(map[int64]TokenSource)
+		}
+		c.installationTokens[installationID] = src
+	}
+	c.installationMu.Unlock()
+
+	if _, err := src.Token(ctx); err != nil {
+		return nil, err
+	}
+
+	c2 := c.Clone()
+	if t, ok := c2.client.Transport.(*tokenSourceTransport); ok {
+		c2.client.Transport = t.Transport
+	}
+	return c2.WithTokenSource(src), nil
+}
+
 // CreateAttachment creates a new attachment on user comment containing a url.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#create-a-content-attachment
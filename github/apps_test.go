@@ -444,3 +444,143 @@ func GetReadCloser(body interface{}) (io.ReadCloser, error) {
 	}
 	return ioutil.NopCloser(bytes.NewBuffer(all)), nil
 }
+
+func TestAppsService_NewInstallationClient(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var minted int
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		minted++
+		expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		fmt.Fprintf(w, `{"token":"t%v","expires_at":%q}`, minted, expiresAt)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t1")
+		fmt.Fprint(w, `{"total_count":0,"repositories":[]}`)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		ic, err := client.Apps.NewInstallationClient(ctx, 1)
+		if err != nil {
+			t.Fatalf("Apps.NewInstallationClient returned error: %v", err)
+		}
+		if _, _, err := ic.Apps.ListRepos(ctx, nil); err != nil {
+			t.Errorf("Apps.ListRepos returned error: %v", err)
+		}
+	}
+
+	if minted != 1 {
+		t.Errorf("Apps.NewInstallationClient minted %v tokens, want 1", minted)
+	}
+}
+
+func TestAppsService_NewInstallationClient_renewsExpiringToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var minted int
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		minted++
+		expiresAt := time.Now().Add(30 * time.Second).UTC().Format(time.RFC3339)
+		fmt.Fprintf(w, `{"token":"t%v","expires_at":%q}`, minted, expiresAt)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", fmt.Sprintf("token t%v", minted))
+		fmt.Fprint(w, `{"total_count":0,"repositories":[]}`)
+	})
+
+	ctx := context.Background()
+	ic, err := client.Apps.NewInstallationClient(ctx, 1)
+	if err != nil {
+		t.Fatalf("Apps.NewInstallationClient returned error: %v", err)
+	}
+	if _, _, err := ic.Apps.ListRepos(ctx, nil); err != nil {
+		t.Errorf("Apps.ListRepos returned error: %v", err)
+	}
+
+	if minted != 2 {
+		t.Errorf("Apps.NewInstallationClient minted %v tokens, want 2", minted)
+	}
+}
+
+func TestAppsService_NewInstallationClient_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+	})
+
+	if _, err := client.Apps.NewInstallationClient(context.Background(), 1); err == nil {
+		t.Error("Apps.NewInstallationClient returned no error, want error")
+	}
+}
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	n int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.n++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestAppsService_NewInstallationClient_appTransport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		fmt.Fprintf(w, `{"token":"t","expires_at":%q}`, expiresAt)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+		fmt.Fprint(w, `{"total_count":0,"repositories":[]}`)
+	})
+
+	base := &countingTransport{}
+	client.client.Transport = base
+
+	ctx := context.Background()
+	ic, err := client.Apps.NewInstallationClient(ctx, 1)
+	if err != nil {
+		t.Fatalf("Apps.NewInstallationClient returned error: %v", err)
+	}
+	if _, _, err := ic.Apps.ListRepos(ctx, nil); err != nil {
+		t.Errorf("Apps.ListRepos returned error: %v", err)
+	}
+
+	if base.n != 2 {
+		t.Errorf("Apps.NewInstallationClient sent %v requests through the app transport, want 2", base.n)
+	}
+}
+
+func TestAppsService_NewInstallationClient_appTokenSource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token jwt")
+		expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		fmt.Fprintf(w, `{"token":"t","expires_at":%q}`, expiresAt)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+		fmt.Fprint(w, `{"total_count":0,"repositories":[]}`)
+	})
+
+	appClient := client.WithTokenSource(StaticTokenSource("jwt"))
+
+	ctx := context.Background()
+	ic, err := appClient.Apps.NewInstallationClient(ctx, 1)
+	if err != nil {
+		t.Fatalf("Apps.NewInstallationClient returned error: %v", err)
+	}
+	if _, _, err := ic.Apps.ListRepos(ctx, nil); err != nil {
+		t.Errorf("Apps.ListRepos returned error: %v", err)
+	}
+}
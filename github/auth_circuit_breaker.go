@@ -0,0 +1,143 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuthCircuitBreakerOptions configures the circuit breaker of a client
+// created with Client.WithAuthCircuitBreaker.
+type AuthCircuitBreakerOptions struct {
+	// Threshold is the number of consecutive authentication failures after
+	// which the breaker trips. Defaults to 5.
+	Threshold int
+
+	// Cooldown is how long the breaker fails requests once tripped, before
+	// letting a request through to check whether the credentials work
+	// again. Defaults to one minute.
+	Cooldown time.Duration
+}
+
+// AuthCircuitOpenError is returned, wrapped in a *url.Error, for requests
+// made while the circuit breaker of a client created with
+// Client.WithAuthCircuitBreaker is tripped. Such requests are not sent.
+type AuthCircuitOpenError struct {
+	Failures int       // number of consecutive authentication failures seen
+	Until    time.Time // time at which a request will be let through again
+}
+
+func (e *AuthCircuitOpenError) Error() string {
+	return fmt.Sprintf("authentication appears invalid: %d consecutive requests failed authentication; not sending requests until %v",
+		e.Failures, e.Until.Format(time.RFC3339))
+}
+
+// WithAuthCircuitBreaker returns a copy of c (see Clone) that stops sending
+// requests once its credentials appear invalid, such as after a token was
+// revoked or expired, instead of spending rate limit on requests bound to
+// fail. A nil opts uses the default AuthCircuitBreakerOptions.
+//
+// A response with status 401 Unauthorized, or 403 Forbidden other than for
+// an exceeded rate limit, counts as an authentication failure; any other
+// response resets the count. After opts.Threshold consecutive failures, the
+// breaker trips: requests fail with an *AuthCircuitOpenError without being
+// sent, until opts.Cooldown has passed. Then requests are let through again,
+// and the next one closes the breaker if it succeeds, or trips it for
+// another cooldown if it fails authentication.
+//
+// Since 403 responses are also returned when valid credentials lack
+// permission, repeatedly requesting a resource the credentials cannot access
+// can trip the breaker too.
+func (c *Client) WithAuthCircuitBreaker(opts *AuthCircuitBreakerOptions) *Client {
+	o := AuthCircuitBreakerOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Threshold <= 0 {
+		o.Threshold = 5
+	}
+	if o.Cooldown <= 0 {
+		o.Cooldown = time.Minute
+	}
+
+	c2 := c.Clone()
+	c2.client.Transport = &authCircuitBreakerTransport{
+		opts:      o,
+		now:       time.Now,
+		Transport: c2.client.Transport,
+	}
+	return c2
+}
+
+// authCircuitBreakerTransport is an http.RoundTripper that fails fast after
+// repeated authentication failures. It is used by
+// Client.WithAuthCircuitBreaker.
+type authCircuitBreakerTransport struct {
+	opts AuthCircuitBreakerOptions
+	now  func() time.Time
+
+	mu        sync.Mutex
+	failures  int       // consecutive authentication failures
+	openUntil time.Time // requests fail fast until then
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *authCircuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	if now := t.now(); now.Before(t.openUntil) {
+		err := &AuthCircuitOpenError{Failures: t.failures, Until: t.openUntil}
+		t.mu.Unlock()
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	t.mu.Unlock()
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !authFailure(resp) {
+		t.failures = 0
+		return resp, nil
+	}
+	t.failures++
+	if t.failures >= t.opts.Threshold {
+		t.openUntil = t.now().Add(t.opts.Cooldown)
+	}
+	return resp, nil
+}
+
+func (t *authCircuitBreakerTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// authFailure reports whether resp indicates that the request's credentials
+// were rejected.
+func authFailure(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusForbidden:
+		rateLimited := resp.Header.Get(headerRateRemaining) == "0" || resp.Header.Get("Retry-After") != ""
+		return !rateLimited
+	}
+	return false
+}
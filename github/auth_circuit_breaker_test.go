@@ -0,0 +1,105 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_WithAuthCircuitBreaker(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	status := http.StatusUnauthorized
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if status != http.StatusOK {
+			http.Error(w, `{"message":"Bad credentials"}`, status)
+			return
+		}
+		fmt.Fprint(w, `{"login":"l"}`)
+	})
+
+	client = client.WithAuthCircuitBreaker(&AuthCircuitBreakerOptions{Threshold: 2, Cooldown: time.Minute})
+	breaker := client.client.Transport.(*authCircuitBreakerTransport)
+	now := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Users.Get(ctx, ""); err == nil {
+			t.Fatal("Users.Get returned no error, want 401")
+		}
+	}
+
+	// The breaker has tripped: requests fail without reaching the server.
+	_, _, err := client.Users.Get(ctx, "")
+	var openErr *AuthCircuitOpenError
+	if !errors.As(err, &openErr) {
+		t.Fatalf("Users.Get returned error %v, want *AuthCircuitOpenError", err)
+	}
+	if openErr.Failures != 2 || !openErr.Until.Equal(now.Add(time.Minute)) {
+		t.Errorf("AuthCircuitOpenError = %+v", openErr)
+	}
+	if calls != 2 {
+		t.Errorf("server was called %v times, want 2", calls)
+	}
+
+	// After the cooldown, a failing request trips the breaker again at once.
+	now = now.Add(time.Minute)
+	client.Users.Get(ctx, "")
+	if _, _, err := client.Users.Get(ctx, ""); !errors.As(err, &openErr) {
+		t.Fatalf("Users.Get returned error %v, want *AuthCircuitOpenError", err)
+	}
+	if calls != 3 {
+		t.Errorf("server was called %v times, want 3", calls)
+	}
+
+	// After the next cooldown, a successful request resets the breaker.
+	now = now.Add(time.Minute)
+	status = http.StatusOK
+	if _, _, err := client.Users.Get(ctx, ""); err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+	status = http.StatusUnauthorized
+	if _, _, err := client.Users.Get(ctx, ""); errors.As(err, &openErr) {
+		t.Errorf("Users.Get returned %v after a reset, want a 401 from the server", err)
+	}
+	if calls != 5 {
+		t.Errorf("server was called %v times, want 5", calls)
+	}
+}
+
+func TestClient_WithAuthCircuitBreaker_ignoresRateLimits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(-time.Second).Unix()))
+		http.Error(w, `{"message":"API rate limit exceeded"}`, http.StatusForbidden)
+	})
+
+	client = client.WithAuthCircuitBreaker(&AuthCircuitBreakerOptions{Threshold: 1})
+	for i := 0; i < 3; i++ {
+		_, _, err := client.Users.Get(context.Background(), "")
+		var openErr *AuthCircuitOpenError
+		if errors.As(err, &openErr) {
+			t.Fatalf("Users.Get tripped the breaker on a rate limit error")
+		}
+	}
+	if calls != 3 {
+		t.Errorf("server was called %v times, want 3", calls)
+	}
+}
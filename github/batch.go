@@ -0,0 +1,96 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxBatchConcurrency is the maximum number of requests that the batch helpers
+// (such as ActivityService.AreStarred) have in flight at once. It is kept low
+// so that batch helpers don't trip GitHub's secondary rate limits.
+const maxBatchConcurrency = 4
+
+// BatchError is returned by helpers that issue several API requests on behalf
+// of the caller when one or more of those requests fail. Successful results
+// are still returned alongside it.
+type BatchError struct {
+	// Errors maps the item each failed request was made for (for example,
+	// a repository full name or a username) to the error it returned.
+	Errors map[string]error
+}
+
+func (e *BatchError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for k := range e.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	msgs := make([]string, len(keys))
+	for i, k := range keys {
+		msgs[i] = fmt.Sprintf("%v: %v", k, e.Errors[k])
+	}
+	return fmt.Sprintf("%d of the batched requests failed: %v", len(keys), strings.Join(msgs, "; "))
+}
+
+// forEachConcurrently calls fn(i) for each index of keys, running at most
+// maxBatchConcurrency calls at once. keys[i] identifies the item fn(i) works
+// on and is used to report its failure. Items not yet started when ctx is
+// done are reported with ctx.Err(). The returned error is nil or a *BatchError.
+func forEachConcurrently(ctx context.Context, keys []string, fn func(i int) error) error {
+	var (
+		mu   sync.Mutex
+		errs = make(map[string]error)
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, maxBatchConcurrency)
+	)
+	fail := func(key string, err error) {
+		mu.Lock()
+		errs[key] = err
+		mu.Unlock()
+	}
+
+	for i, key := range keys {
+		select {
+		case <-ctx.Done():
+			fail(key, ctx.Err())
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, key string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				fail(key, err)
+			}
+		}(i, key)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return &BatchError{Errors: errs}
+}
+
+// splitFullName splits a repository full name, as used to identify the
+// repositories batch helpers work on, into its owner and name.
+func splitFullName(fullName string) (owner, repo string, err error) {
+	parts := strings.SplitN(fullName, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid repository full name %q, want owner/repo", fullName)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BatchService provides a few reads that the REST API makes expensive,
+// fetching data about many issues or pull requests at once through the GitHub
+// GraphQL API. Each method makes one GraphQL request per
+// maxGraphQLBatchSize items, where the REST API needs one or more requests
+// per item.
+//
+// GraphQL requests count against the GraphQL rate limit, measured in points
+// rather than requests, and not against the REST API rate limit. The cost of
+// each query is documented on the method that makes it.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/overview/resource-limitations
+type BatchService service
+
+// maxGraphQLBatchSize is the maximum number of items BatchService looks up in
+// a single GraphQL query.
+const maxGraphQLBatchSize = 50
+
+// PullRequestReviewDecision is the review state of a pull request.
+type PullRequestReviewDecision struct {
+	Number *int `json:"number,omitempty"`
+	// State is one of: OPEN, CLOSED, MERGED.
+	State *string `json:"state,omitempty"`
+	// ReviewDecision is one of: APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED.
+	// It is nil if no reviews are required on the base branch and none were
+	// submitted.
+	ReviewDecision *string `json:"reviewDecision,omitempty"`
+}
+
+// IssueLabelsAndAssignees is the names of the labels and the logins of the
+// assignees of an issue or pull request.
+type IssueLabelsAndAssignees struct {
+	Number    *int     `json:"number,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+}
+
+// batchQuery returns a GraphQL query looking up each of numbers in the
+// repository given by the $owner and $repo variables, using the field
+// "<rootField>(number: N)" aliased to "item<i>" and selecting selection.
+func batchQuery(rootField, selection string, numbers []int) string {
+	var b strings.Builder
+	b.WriteString("query($owner: String!, $repo: String!) {\n  repository(owner: $owner, name: $repo) {\n")
+	for i, n := range numbers {
+		fmt.Fprintf(&b, "    item%d: %v(number: %d) { %v }\n", i, rootField, n, selection)
+	}
+	b.WriteString("  }\n}")
+	return b.String()
+}
+
+// forEachGraphQLBatch calls fn with each consecutive chunk of at most
+// maxGraphQLBatchSize numbers, stopping at the first error.
+func forEachGraphQLBatch(numbers []int, fn func(chunk []int) (*Response, error)) (*Response, error) {
+	var resp *Response
+	for len(numbers) > 0 {
+		n := len(numbers)
+		if n > maxGraphQLBatchSize {
+			n = maxGraphQLBatchSize
+		}
+		var err error
+		if resp, err = fn(numbers[:n]); err != nil {
+			return resp, err
+		}
+		numbers = numbers[n:]
+	}
+	return resp, nil
+}
+
+// GetPullRequestReviewDecisions gets the state and review decision of the
+// pull requests with the given numbers, in the same order. With the REST API,
+// this takes listing the reviews of each pull request and evaluating the
+// branch protection rules of its base branch.
+//
+// Each GraphQL query looks up to 50 pull requests and costs 1 point.
+// If any of the pull requests does not exist, a *GraphQLErrorResponse is
+// returned.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#pullrequest
+func (s *BatchService) GetPullRequestReviewDecisions(ctx context.Context, owner, repo string, numbers []int) ([]*PullRequestReviewDecision, *Response, error) {
+	var decisions []*PullRequestReviewDecision
+	resp, err := forEachGraphQLBatch(numbers, func(chunk []int) (*Response, error) {
+		query := batchQuery("pullRequest", "number state reviewDecision", chunk)
+		vars := map[string]interface{}{"owner": owner, "repo": repo}
+
+		var result struct {
+			Repository map[string]*PullRequestReviewDecision `json:"repository"`
+		}
+		resp, err := s.client.graphQL(ctx, query, vars, &result)
+		if err != nil {
+			return resp, err
+		}
+		for i := range chunk {
+			decisions = append(decisions, result.Repository[fmt.Sprintf("item%d", i)])
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return decisions, resp, nil
+}
+
+// GetIssueLabelsAndAssignees gets the labels and assignees of the issues or
+// pull requests with the given numbers, in the same order. With the REST API,
+// this takes a request per issue.
+//
+// Up to 100 labels and 10 assignees are returned per issue. Each GraphQL
+// query looks up to 50 issues and costs 1 point, since it requests two
+// connections per issue. If any of the issues does not exist, a
+// *GraphQLErrorResponse is returned.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#issue
+func (s *BatchService) GetIssueLabelsAndAssignees(ctx context.Context, owner, repo string, numbers []int) ([]*IssueLabelsAndAssignees, *Response, error) {
+	const fields = `number labels(first: 100) { nodes { name } } assignees(first: 10) { nodes { login } }`
+
+	var issues []*IssueLabelsAndAssignees
+	resp, err := forEachGraphQLBatch(numbers, func(chunk []int) (*Response, error) {
+		query := batchQuery("issueOrPullRequest", "... on Issue { "+fields+" } ... on PullRequest { "+fields+" }", chunk)
+		vars := map[string]interface{}{"owner": owner, "repo": repo}
+
+		var result struct {
+			Repository map[string]*struct {
+				Number *int `json:"number"`
+				Labels struct {
+					Nodes []struct {
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"labels"`
+				Assignees struct {
+					Nodes []struct {
+						Login string `json:"login"`
+					} `json:"nodes"`
+				} `json:"assignees"`
+			} `json:"repository"`
+		}
+		resp, err := s.client.graphQL(ctx, query, vars, &result)
+		if err != nil {
+			return resp, err
+		}
+		for i := range chunk {
+			item := result.Repository[fmt.Sprintf("item%d", i)]
+			if item == nil {
+				issues = append(issues, nil)
+				continue
+			}
+			issue := &IssueLabelsAndAssignees{Number: item.Number}
+			for _, l := range item.Labels.Nodes {
+				issue.Labels = append(issue.Labels, l.Name)
+			}
+			for _, a := range item.Assignees.Nodes {
+				issue.Assignees = append(issue.Assignees, a.Login)
+			}
+			issues = append(issues, issue)
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issues, resp, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestBatchService_GetPullRequestReviewDecisions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// Answer each "itemN: pullRequest(number: M)" with an approved pull
+	// request M, so that batching over several queries can be checked.
+	itemRE := regexp.MustCompile(`(item\d+): pullRequest\(number: (\d+)\)`)
+	queries := 0
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		queries++
+		body := decodeGraphQLRequest(t, r)
+		if want := map[string]interface{}{"owner": "o", "repo": "r"}; !reflect.DeepEqual(body.Variables, want) {
+			t.Errorf("GraphQL variables are %+v, want %+v", body.Variables, want)
+		}
+		items := map[string]interface{}{}
+		for _, m := range itemRE.FindAllStringSubmatch(body.Query, -1) {
+			items[m[1]] = json.RawMessage(fmt.Sprintf(`{"number":%v,"state":"OPEN","reviewDecision":"APPROVED"}`, m[2]))
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"repository": items}})
+	})
+
+	var numbers []int
+	var want []*PullRequestReviewDecision
+	for n := 1; n <= maxGraphQLBatchSize+1; n++ {
+		numbers = append(numbers, n)
+		want = append(want, &PullRequestReviewDecision{Number: Int(n), State: String("OPEN"), ReviewDecision: String("APPROVED")})
+	}
+
+	got, _, err := client.Batch.GetPullRequestReviewDecisions(context.Background(), "o", "r", numbers)
+	if err != nil {
+		t.Fatalf("Batch.GetPullRequestReviewDecisions returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Batch.GetPullRequestReviewDecisions returned %+v, want %+v", got, want)
+	}
+	if queries != 2 {
+		t.Errorf("Batch.GetPullRequestReviewDecisions made %v queries, want 2", queries)
+	}
+}
+
+func TestBatchService_GetPullRequestReviewDecisions_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"item0":null}},"errors":[{"type":"NOT_FOUND","path":["repository","item0"],"message":"Could not resolve to a PullRequest with the number of 9."}]}`)
+	})
+
+	_, _, err := client.Batch.GetPullRequestReviewDecisions(context.Background(), "o", "r", []int{9})
+	if _, ok := err.(*GraphQLErrorResponse); !ok {
+		t.Errorf("Batch.GetPullRequestReviewDecisions returned error %v, want *GraphQLErrorResponse", err)
+	}
+}
+
+func TestBatchService_GetIssueLabelsAndAssignees(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := decodeGraphQLRequest(t, r)
+		for _, s := range []string{"item0: issueOrPullRequest(number: 1)", "item1: issueOrPullRequest(number: 2)", "... on PullRequest"} {
+			if !strings.Contains(body.Query, s) {
+				t.Errorf("GraphQL query %q does not contain %q", body.Query, s)
+			}
+		}
+		fmt.Fprint(w, `{"data":{"repository":{
+			"item0":{"number":1,"labels":{"nodes":[{"name":"bug"},{"name":"p1"}]},"assignees":{"nodes":[{"login":"u"}]}},
+			"item1":{"number":2,"labels":{"nodes":[]},"assignees":{"nodes":[]}}
+		}}}`)
+	})
+
+	got, _, err := client.Batch.GetIssueLabelsAndAssignees(context.Background(), "o", "r", []int{1, 2})
+	if err != nil {
+		t.Fatalf("Batch.GetIssueLabelsAndAssignees returned error: %v", err)
+	}
+
+	want := []*IssueLabelsAndAssignees{
+		{Number: Int(1), Labels: []string{"bug", "p1"}, Assignees: []string{"u"}},
+		{Number: Int(2)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Batch.GetIssueLabelsAndAssignees returned %+v, want %+v", got, want)
+	}
+}
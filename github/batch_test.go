@@ -0,0 +1,65 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBatchError_Error(t *testing.T) {
+	err := &BatchError{Errors: map[string]error{
+		"o/b": errors.New("boom"),
+		"o/a": errors.New("bang"),
+	}}
+
+	want := "2 of the batched requests failed: o/a: bang; o/b: boom"
+	if got := err.Error(); got != want {
+		t.Errorf("BatchError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e", "f"}
+	got := make([]string, len(keys))
+	err := forEachConcurrently(context.Background(), keys, func(i int) error {
+		if keys[i] == "c" {
+			return errors.New("failed")
+		}
+		got[i] = keys[i]
+		return nil
+	})
+
+	be, ok := err.(*BatchError)
+	if !ok || len(be.Errors) != 1 || be.Errors["c"] == nil {
+		t.Fatalf("forEachConcurrently returned %v, want *BatchError for c", err)
+	}
+	for i, k := range keys {
+		if k != "c" && got[i] != k {
+			t.Errorf("fn was not called for %q", k)
+		}
+	}
+}
+
+func TestForEachConcurrently_canceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := forEachConcurrently(ctx, []string{"a", "b"}, func(i int) error {
+		return ctx.Err()
+	})
+
+	be, ok := err.(*BatchError)
+	if !ok || len(be.Errors) != 2 {
+		t.Fatalf("forEachConcurrently returned %v, want *BatchError for every key", err)
+	}
+	for k, err := range be.Errors {
+		if err != context.Canceled {
+			t.Errorf("error for %q is %v, want context.Canceled", k, err)
+		}
+	}
+}
@@ -29,6 +29,9 @@ type Alert struct {
 	ClosedAt        *Timestamp `json:"closed_at,omitempty"`
 	URL             *string    `json:"url,omitempty"`
 	HTMLURL         *string    `json:"html_url,omitempty"`
+
+	// Repository is only populated by ListAlertsForOrg.
+	Repository *Repository `json:"repository,omitempty"`
 }
 
 // ID returns the ID associated with an alert. It is the number at the end of the security alert's URL.
@@ -61,6 +64,15 @@ type AlertListOptions struct {
 
 	// Return code scanning alerts for a specific branch reference. The ref must be formatted as heads/<branch name>.
 	Ref string `url:"ref,omitempty"`
+
+	// ToolName returns only the alerts found by the code scanning tool with this name, such as "CodeQL".
+	ToolName string `url:"tool_name,omitempty"`
+
+	// Severity returns only the alerts of this severity. Possible values are:
+	// critical, high, medium, low, warning, note, error.
+	Severity string `url:"severity,omitempty"`
+
+	ListOptions
 }
 
 // ListAlertsForRepo lists code scanning alerts for a repository.
@@ -91,6 +103,56 @@ func (s *CodeScanningService) ListAlertsForRepo(ctx context.Context, owner, repo
 	return alerts, resp, nil
 }
 
+// ListAlertsForOrg lists code scanning alerts for the repositories of an
+// organization. The alerts include the repository they were found in.
+//
+// You must use an access token with the security_events scope to use this endpoint, and be an owner or
+// security manager of the organization. GitHub Apps must have the security_events read permission to use
+// this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning#list-code-scanning-alerts-for-an-organization
+func (s *CodeScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *AlertListOptions) ([]*Alert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/code-scanning/alerts", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*Alert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// ListAlertsForOrgAll lists all code scanning alerts for the repositories of
+// an organization, following pagination until every page has been fetched.
+// opts.Page is used as the first page to fetch.
+//
+// If a request fails, the alerts fetched so far are returned along with the
+// error.
+func (s *CodeScanningService) ListAlertsForOrgAll(ctx context.Context, org string, opts *AlertListOptions) ([]*Alert, *Response, error) {
+	o := new(AlertListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Alert
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		alerts, resp, err := s.ListAlertsForOrg(ctx, org, o)
+		all = append(all, alerts...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetAlert gets a single code scanning alert for a repository.
 //
 // You must use an access token with the security_events scope to use this endpoint.
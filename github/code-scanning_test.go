@@ -123,6 +123,84 @@ func TestActionsService_ListAlertsForRepo(t *testing.T) {
 	}
 }
 
+func TestCodeScanningService_ListAlertsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"state":     "open",
+			"ref":       "heads/main",
+			"tool_name": "CodeQL",
+			"severity":  "high",
+			"per_page":  "50",
+		})
+		fmt.Fprint(w, `[{
+			"rule_id":"js/trivial-conditional",
+			"rule_severity":"warning",
+			"tool":"CodeQL",
+			"open":true,
+			"html_url":"https://github.com/o/r/security/code-scanning/25",
+			"repository":{"id":1,"full_name":"o/r"}
+		}]`)
+	})
+
+	opts := &AlertListOptions{State: "open", Ref: "heads/main", ToolName: "CodeQL", Severity: "high", ListOptions: ListOptions{PerPage: 50}}
+	alerts, _, err := client.CodeScanning.ListAlertsForOrg(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAlertsForOrg returned error: %v", err)
+	}
+
+	want := []*Alert{{
+		RuleID:       String("js/trivial-conditional"),
+		RuleSeverity: String("warning"),
+		Tool:         String("CodeQL"),
+		Open:         Bool(true),
+		HTMLURL:      String("https://github.com/o/r/security/code-scanning/25"),
+		Repository:   &Repository{ID: Int64(1), FullName: String("o/r")},
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("CodeScanning.ListAlertsForOrg returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestCodeScanningService_ListAlertsForOrg_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.CodeScanning.ListAlertsForOrg(context.Background(), "%", nil)
+	testURLParseError(t, err)
+}
+
+func TestCodeScanningService_ListAlertsForOrgAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/code-scanning/alerts?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"rule_id":"a"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"rule_id":"b"}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	alerts, _, err := client.CodeScanning.ListAlertsForOrgAll(context.Background(), "o", nil)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAlertsForOrgAll returned error: %v", err)
+	}
+
+	want := []*Alert{{RuleID: String("a")}, {RuleID: String("b")}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("CodeScanning.ListAlertsForOrgAll returned %+v, want %+v", alerts, want)
+	}
+}
+
 func TestActionsService_GetAlert(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
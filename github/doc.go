@@ -135,7 +135,8 @@ The GitHub API has good support for conditional requests which will help
 prevent you from burning through your rate limit, as well as help speed up your
 application. go-github does not handle conditional requests directly, but is
 instead designed to work with a caching http.Transport. We recommend using
-https://github.com/gregjones/httpcache for that.
+https://github.com/gregjones/httpcache for that. Responses served by such a
+transport have Response.FromCache set.
 
 Learn more about GitHub conditional requests at
 https://docs.github.com/en/free-pro-team@latest/rest/reference/#conditional-requests.
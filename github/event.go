@@ -100,12 +100,16 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 		payload = &PullRequestReviewCommentEvent{}
 	case "PushEvent":
 		payload = &PushEvent{}
+	case "RegistryPackageEvent":
+		payload = &RegistryPackageEvent{}
 	case "ReleaseEvent":
 		payload = &ReleaseEvent{}
 	case "RepositoryEvent":
 		payload = &RepositoryEvent{}
 	case "RepositoryDispatchEvent":
 		payload = &RepositoryDispatchEvent{}
+	case "RepositoryRulesetEvent":
+		payload = &RepositoryRulesetEvent{}
 	case "RepositoryVulnerabilityAlertEvent":
 		payload = &RepositoryVulnerabilityAlertEvent{}
 	case "StarEvent":
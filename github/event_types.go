@@ -231,6 +231,14 @@ type EditChange struct {
 	} `json:"base,omitempty"`
 }
 
+// MemberChanges represents the changes to the permission of a collaborator.
+type MemberChanges struct {
+	Permission *struct {
+		From *string `json:"from,omitempty"`
+		To   *string `json:"to,omitempty"`
+	} `json:"permission,omitempty"`
+}
+
 // ProjectChange represents the changes when a project has been edited.
 type ProjectChange struct {
 	Name *struct {
@@ -385,9 +393,11 @@ type MarketplacePurchaseEvent struct {
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/events/types/#memberevent
 type MemberEvent struct {
-	// Action is the action that was performed. Possible value is: "added".
-	Action *string `json:"action,omitempty"`
-	Member *User   `json:"member,omitempty"`
+	// Action is the action that was performed. Possible values are: "added",
+	// "removed", and "edited". Only "added" is reported in timelines.
+	Action  *string        `json:"action,omitempty"`
+	Member  *User          `json:"member,omitempty"`
+	Changes *MemberChanges `json:"changes,omitempty"`
 
 	// The following fields are only populated by Webhook events.
 	Repo         *Repository   `json:"repository,omitempty"`
@@ -783,6 +793,25 @@ type PushEventRepoOwner struct {
 	Email *string `json:"email,omitempty"`
 }
 
+// RegistryPackageEvent is triggered when a package is published or updated
+// in GitHub Packages.
+// The Webhook event name is "registry_package".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#registry_package
+type RegistryPackageEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "published", "updated".
+	Action          *string       `json:"action,omitempty"`
+	RegistryPackage *Package      `json:"registry_package,omitempty"`
+	Repository      *Repository   `json:"repository,omitempty"`
+	Organization    *Organization `json:"organization,omitempty"`
+	Enterprise      *Enterprise   `json:"enterprise,omitempty"`
+	Sender          *User         `json:"sender,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Installation *Installation `json:"installation,omitempty"`
+}
+
 // ReleaseEvent is triggered when a release is published, unpublished, created,
 // edited, deleted, or prereleased.
 // The Webhook event name is "release".
@@ -838,6 +867,92 @@ type RepositoryDispatchEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// RepositoryRulesetEvent is triggered when a repository ruleset is created,
+// edited, or deleted.
+// The Webhook event name is "repository_ruleset".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#repository_ruleset
+type RepositoryRulesetEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "created", "edited", "deleted".
+	Action            *string                   `json:"action,omitempty"`
+	RepositoryRuleset *Ruleset                  `json:"repository_ruleset,omitempty"`
+	Changes           *RepositoryRulesetChanges `json:"changes,omitempty"`
+	Repository        *Repository               `json:"repository,omitempty"`
+	Organization      *Organization             `json:"organization,omitempty"`
+	Enterprise        *Enterprise               `json:"enterprise,omitempty"`
+	Sender            *User                     `json:"sender,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// RepositoryRulesetChanges represents the changes made to a ruleset, for
+// RepositoryRulesetEvents with the "edited" action.
+type RepositoryRulesetChanges struct {
+	Name *struct {
+		From *string `json:"from,omitempty"`
+	} `json:"name,omitempty"`
+	Enforcement *struct {
+		From *string `json:"from,omitempty"`
+	} `json:"enforcement,omitempty"`
+	Conditions *RulesetConditionsChanges `json:"conditions,omitempty"`
+	Rules      *RulesetRulesChanges      `json:"rules,omitempty"`
+}
+
+// RulesetConditionsChanges represents the conditions added to, deleted from,
+// or updated in a ruleset.
+type RulesetConditionsChanges struct {
+	Added   []*RulesetConditions        `json:"added,omitempty"`
+	Deleted []*RulesetConditions        `json:"deleted,omitempty"`
+	Updated []*RulesetUpdatedConditions `json:"updated,omitempty"`
+}
+
+// RulesetUpdatedConditions represents a ruleset condition as updated, along
+// with its previous values.
+type RulesetUpdatedConditions struct {
+	Condition *RulesetConditions `json:"condition,omitempty"`
+	Changes   *struct {
+		ConditionType *struct {
+			From *string `json:"from,omitempty"`
+		} `json:"condition_type,omitempty"`
+		Target *struct {
+			From *string `json:"from,omitempty"`
+		} `json:"target,omitempty"`
+		Include *struct {
+			From []string `json:"from,omitempty"`
+		} `json:"include,omitempty"`
+		Exclude *struct {
+			From []string `json:"from,omitempty"`
+		} `json:"exclude,omitempty"`
+	} `json:"changes,omitempty"`
+}
+
+// RulesetRulesChanges represents the rules added to, deleted from, or
+// updated in a ruleset.
+type RulesetRulesChanges struct {
+	Added   []*RepositoryRule     `json:"added,omitempty"`
+	Deleted []*RepositoryRule     `json:"deleted,omitempty"`
+	Updated []*RulesetUpdatedRule `json:"updated,omitempty"`
+}
+
+// RulesetUpdatedRule represents a ruleset rule as updated, along with its
+// previous values.
+type RulesetUpdatedRule struct {
+	Rule    *RepositoryRule `json:"rule,omitempty"`
+	Changes *struct {
+		Configuration *struct {
+			From *string `json:"from,omitempty"`
+		} `json:"configuration,omitempty"`
+		RuleType *struct {
+			From *string `json:"from,omitempty"`
+		} `json:"rule_type,omitempty"`
+		Pattern *struct {
+			From *string `json:"from,omitempty"`
+		} `json:"pattern,omitempty"`
+	} `json:"changes,omitempty"`
+}
+
 // RepositoryVulnerabilityAlertEvent is triggered when a security alert is created, dismissed, or resolved.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/events/types/#repositoryvulnerabilityalertevent
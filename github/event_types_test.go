@@ -6,6 +6,7 @@
 package github
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -103,3 +104,100 @@ func TestEditChange_Marshal_BaseChange(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestRepositoryRulesetEvent_ParseWebHook(t *testing.T) {
+	payload := `{
+		"action": "edited",
+		"repository_ruleset": {
+			"id": 21,
+			"name": "protect main",
+			"target": "branch",
+			"source_type": "Repository",
+			"source": "o/r",
+			"enforcement": "active",
+			"bypass_actors": [{"actor_id": 5, "actor_type": "Team", "bypass_mode": "always"}],
+			"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"]}},
+			"rules": [
+				{"type": "deletion"},
+				{"type": "pull_request", "parameters": {"required_approving_review_count": 2}}
+			]
+		},
+		"changes": {
+			"enforcement": {"from": "evaluate"},
+			"rules": {
+				"added": [{"type": "deletion"}],
+				"updated": [{"rule": {"type": "pull_request"}, "changes": {"configuration": {"from": "{}"}}}]
+			}
+		},
+		"repository": {"id": 1, "full_name": "o/r"},
+		"sender": {"login": "l"}
+	}`
+
+	parsed, err := ParseWebHook("repository_ruleset", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+	event, ok := parsed.(*RepositoryRulesetEvent)
+	if !ok {
+		t.Fatalf("ParseWebHook returned %T, want *RepositoryRulesetEvent", parsed)
+	}
+
+	ruleset := event.GetRepositoryRuleset()
+	if got, want := ruleset.GetEnforcement(), "active"; got != want {
+		t.Errorf("Enforcement = %q, want %q", got, want)
+	}
+	if got, want := ruleset.GetConditions().GetRefName().Include, []string{"~DEFAULT_BRANCH"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Conditions.RefName.Include = %v, want %v", got, want)
+	}
+	if got, want := string(*ruleset.Rules[1].Parameters), `{"required_approving_review_count": 2}`; got != want {
+		t.Errorf("Rules[1].Parameters = %s, want %s", got, want)
+	}
+	if got, want := *event.GetChanges().Enforcement.From, "evaluate"; got != want {
+		t.Errorf("Changes.Enforcement.From = %q, want %q", got, want)
+	}
+	if got, want := *event.GetChanges().GetRules().Updated[0].Changes.Configuration.From, "{}"; got != want {
+		t.Errorf("Changes.Rules.Updated[0].Changes.Configuration.From = %q, want %q", got, want)
+	}
+
+	testJSONMarshal(t, event, payload)
+}
+
+func TestRegistryPackageEvent_ParseWebHook(t *testing.T) {
+	payload := `{
+		"action": "published",
+		"registry_package": {
+			"id": 3,
+			"name": "app",
+			"namespace": "o",
+			"description": "d",
+			"ecosystem": "CONTAINER",
+			"package_type": "CONTAINER",
+			"html_url": "https://github.com/o/r/pkgs/container/app",
+			"owner": {"login": "o"},
+			"package_version": {"id": 7, "version": "sha256:abc", "html_url": "https://github.com/o/r/pkgs/container/app/7"},
+			"registry": {"name": "GitHub CONTAINER registry", "type": "CONTAINER", "url": "https://ghcr.io/o", "vendor": "GitHub Inc"}
+		},
+		"repository": {"id": 1, "full_name": "o/r"},
+		"organization": {"login": "o"},
+		"sender": {"login": "l"}
+	}`
+
+	parsed, err := ParseWebHook("registry_package", []byte(payload))
+	if err != nil {
+		t.Fatalf("ParseWebHook returned error: %v", err)
+	}
+	event, ok := parsed.(*RegistryPackageEvent)
+	if !ok {
+		t.Fatalf("ParseWebHook returned %T, want *RegistryPackageEvent", parsed)
+	}
+
+	pkg := event.GetRegistryPackage()
+	if got, want := pkg.GetEcosystem(), "CONTAINER"; got != want {
+		t.Errorf("Ecosystem = %q, want %q", got, want)
+	}
+	if got, want := pkg.GetPackageVersion().GetVersion(), "sha256:abc"; got != want {
+		t.Errorf("PackageVersion.Version = %q, want %q", got, want)
+	}
+
+	testJSONMarshal(t, event, payload)
+}
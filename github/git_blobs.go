@@ -8,7 +8,10 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"strings"
 )
 
 // Blob represents a blob object.
@@ -67,3 +70,66 @@ func (s *GitService) CreateBlob(ctx context.Context, owner string, repo string,
 	resp, err := s.client.Do(ctx, req, t)
 	return t, resp, err
 }
+
+// blobReaderPrefix and blobReaderSuffix surround the base64-encoded content
+// streamed by CreateBlobFromReader. The base64 alphabet never needs escaping
+// within a JSON string, so the body can be assembled without buffering.
+const (
+	blobReaderPrefix = `{"encoding":"base64","content":"`
+	blobReaderSuffix = `"}`
+)
+
+// CreateBlobFromReader creates a blob object from the size bytes read from r
+// and returns the SHA of the new blob. Unlike CreateBlob, the content is
+// base64-encoded while the request body is being sent, so memory usage stays
+// bounded regardless of the size of the content.
+//
+// size must be the exact number of bytes that will be read from r.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#create-a-blob
+func (s *GitService) CreateBlobFromReader(ctx context.Context, owner, repo string, r io.Reader, size int64) (string, *Response, error) {
+	if size < 0 {
+		return "", nil, fmt.Errorf("size must be non-negative, got %d", size)
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/git/blobs", owner, repo)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		enc := base64.NewEncoder(base64.StdEncoding, pw)
+		_, err := io.Copy(enc, io.LimitReader(r, size))
+		if err == nil {
+			err = enc.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req.Body = &blobReaderBody{
+		Reader: io.MultiReader(strings.NewReader(blobReaderPrefix), pr, strings.NewReader(blobReaderSuffix)),
+		pipe:   pr,
+	}
+	req.ContentLength = int64(len(blobReaderPrefix)) + (size+2)/3*4 + int64(len(blobReaderSuffix))
+	req.Header.Set("Content-Type", "application/json")
+
+	t := new(Blob)
+	resp, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return "", resp, err
+	}
+	return t.GetSHA(), resp, nil
+}
+
+// blobReaderBody is the request body used by CreateBlobFromReader. Closing it
+// stops the goroutine encoding the content.
+type blobReaderBody struct {
+	io.Reader
+	pipe *io.PipeReader
+}
+
+func (b *blobReaderBody) Close() error {
+	return b.pipe.Close()
+}
@@ -8,10 +8,12 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -122,3 +124,50 @@ func TestGitService_CreateBlob_invalidOwner(t *testing.T) {
 	_, _, err := client.Git.CreateBlob(context.Background(), "%", "%", &Blob{})
 	testURLParseError(t, err)
 }
+
+func TestGitService_CreateBlobFromReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	content := strings.Repeat("large generated file\n", 1000)
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		v := new(Blob)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		if got, want := v.GetEncoding(), "base64"; got != want {
+			t.Errorf("Git.CreateBlobFromReader request encoding is %q, want %q", got, want)
+		}
+		got, err := base64.StdEncoding.DecodeString(v.GetContent())
+		if err != nil {
+			t.Fatalf("DecodeString returned error: %v", err)
+		}
+		if string(got) != content {
+			t.Errorf("Git.CreateBlobFromReader request content mismatch")
+		}
+
+		fmt.Fprint(w, `{"sha": "s", "url": "u"}`)
+	})
+
+	sha, _, err := client.Git.CreateBlobFromReader(context.Background(), "o", "r", strings.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Errorf("Git.CreateBlobFromReader returned error: %v", err)
+	}
+	if want := "s"; sha != want {
+		t.Errorf("Git.CreateBlobFromReader returned %q, want %q", sha, want)
+	}
+}
+
+func TestGitService_CreateBlobFromReader_invalidSize(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Git.CreateBlobFromReader(context.Background(), "o", "r", strings.NewReader(""), -1)
+	if err == nil {
+		t.Error("Git.CreateBlobFromReader returned nil error, want error for negative size")
+	}
+}
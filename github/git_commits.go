@@ -18,10 +18,17 @@ import (
 
 // SignatureVerification represents GPG signature verification.
 type SignatureVerification struct {
-	Verified  *bool   `json:"verified,omitempty"`
-	Reason    *string `json:"reason,omitempty"`
-	Signature *string `json:"signature,omitempty"`
-	Payload   *string `json:"payload,omitempty"`
+	Verified *bool `json:"verified,omitempty"`
+	// Reason explains the value of Verified. Possible values include: valid,
+	// unsigned, unknown_key, bad_email, unverified_email, no_user,
+	// unknown_signature_type, expired_key, not_signing_key, gpgverify_error,
+	// gpgverify_unavailable, malformed_signature, invalid and bad_cert.
+	Reason *string `json:"reason,omitempty"`
+	// Signature is the signature extracted from the commit, and Payload is
+	// the signed content.
+	Signature  *string    `json:"signature,omitempty"`
+	Payload    *string    `json:"payload,omitempty"`
+	VerifiedAt *Timestamp `json:"verified_at,omitempty"`
 }
 
 // Commit represents a GitHub commit.
@@ -53,6 +60,13 @@ func (c Commit) String() string {
 	return Stringify(c)
 }
 
+// IsVerified reports whether GitHub verified the signature of the commit.
+// It is false for unsigned commits, and for commits whose Verification was
+// not included in the response.
+func (c *Commit) IsVerified() bool {
+	return c.GetVerification().GetVerified()
+}
+
 // CommitAuthor represents the author or committer of a commit. The commit
 // author may not correspond to a GitHub User.
 type CommitAuthor struct {
@@ -104,6 +118,10 @@ type createCommit struct {
 // data if omitted. If the commit.Author is omitted, it will be filled in with
 // the authenticated user’s information and the current date.
 //
+// Each of commit.Parents must have its SHA set. A commit with several
+// parents is a merge commit; its parents are kept in the given order, the
+// first one being the commit merged into.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#create-a-commit
 func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string, commit *Commit) (*Commit, *Response, error) {
 	if commit == nil {
@@ -114,7 +132,10 @@ func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string
 
 	parents := make([]string, len(commit.Parents))
 	for i, parent := range commit.Parents {
-		parents[i] = *parent.SHA
+		if parent.GetSHA() == "" {
+			return nil, nil, fmt.Errorf("parent %d of the commit has no SHA", i)
+		}
+		parents[i] = parent.GetSHA()
 	}
 
 	body := &createCommit{
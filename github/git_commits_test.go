@@ -138,6 +138,55 @@ func TestGitService_GetCommit(t *testing.T) {
 	}
 }
 
+func TestGitService_GetCommit_verified(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"s","verification":{"verified":true,"reason":"valid","signature":"-----BEGIN PGP SIGNATURE-----\n...","payload":"tree t\nparent p\n","verified_at":`+referenceTimeStr+`}}`)
+	})
+
+	commit, _, err := client.Git.GetCommit(context.Background(), "o", "r", "s")
+	if err != nil {
+		t.Errorf("Git.GetCommit returned error: %v", err)
+	}
+
+	want := &Commit{
+		SHA: String("s"),
+		Verification: &SignatureVerification{
+			Verified:   Bool(true),
+			Reason:     String("valid"),
+			Signature:  String("-----BEGIN PGP SIGNATURE-----\n..."),
+			Payload:    String("tree t\nparent p\n"),
+			VerifiedAt: &Timestamp{referenceTime},
+		},
+	}
+	if !reflect.DeepEqual(commit, want) {
+		t.Errorf("Git.GetCommit returned %+v, want %+v", commit, want)
+	}
+	if !commit.IsVerified() {
+		t.Error("Commit.IsVerified returned false, want true")
+	}
+}
+
+func TestCommit_IsVerified(t *testing.T) {
+	tests := []struct {
+		commit *Commit
+		want   bool
+	}{
+		{nil, false},
+		{&Commit{}, false},
+		{&Commit{Verification: &SignatureVerification{Verified: Bool(false), Reason: String("unsigned")}}, false},
+		{&Commit{Verification: &SignatureVerification{Verified: Bool(true), Reason: String("valid")}}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.commit.IsVerified(); got != tt.want {
+			t.Errorf("Commit.IsVerified() = %v for %v, want %v", got, tt.commit, tt.want)
+		}
+	}
+}
+
 func TestGitService_GetCommit_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -184,6 +233,46 @@ func TestGitService_CreateCommit(t *testing.T) {
 	}
 }
 
+func TestGitService_CreateCommit_mergeCommit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Commit{
+		Message: String("Merge branch 'feature'"),
+		Tree:    &Tree{SHA: String("t")},
+		Parents: []*Commit{{SHA: String("main")}, {SHA: String("feature")}},
+	}
+
+	mux.HandleFunc("/repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"message":"Merge branch 'feature'","tree":"t","parents":["main","feature"]}`+"\n")
+		fmt.Fprint(w, `{"sha":"m","parents":[{"sha":"main"},{"sha":"feature"}]}`)
+	})
+
+	commit, _, err := client.Git.CreateCommit(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Git.CreateCommit returned error: %v", err)
+	}
+
+	want := &Commit{SHA: String("m"), Parents: []*Commit{{SHA: String("main")}, {SHA: String("feature")}}}
+	if !reflect.DeepEqual(commit, want) {
+		t.Errorf("Git.CreateCommit returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestGitService_CreateCommit_parentWithoutSHA(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	input := &Commit{
+		Message: String("m"),
+		Parents: []*Commit{{SHA: String("p")}, {}},
+	}
+	if _, _, err := client.Git.CreateCommit(context.Background(), "o", "r", input); err == nil {
+		t.Error("Git.CreateCommit returned nil error, want an error")
+	}
+}
+
 func TestGitService_CreateSignedCommit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -20,6 +20,30 @@ func (a *AbuseRateLimitError) GetRetryAfter() time.Duration {
 	return *a.RetryAfter
 }
 
+// GetAllowedActions returns the AllowedActions field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsRepository) GetAllowedActions() string {
+	if a == nil || a.AllowedActions == nil {
+		return ""
+	}
+	return *a.AllowedActions
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsRepository) GetEnabled() bool {
+	if a == nil || a.Enabled == nil {
+		return false
+	}
+	return *a.Enabled
+}
+
+// GetSelectedActionsURL returns the SelectedActionsURL field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissionsRepository) GetSelectedActionsURL() string {
+	if a == nil || a.SelectedActionsURL == nil {
+		return ""
+	}
+	return *a.SelectedActionsURL
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (a *AdminEnforcement) GetURL() string {
 	if a == nil || a.URL == nil {
@@ -108,6 +132,94 @@ func (a *AdminStats) GetUsers() *UserStats {
 	return a.Users
 }
 
+// GetScore returns the Score field.
+func (a *AdvisoryCVSS) GetScore() *float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Score
+}
+
+// GetVectorString returns the VectorString field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCVSS) GetVectorString() string {
+	if a == nil || a.VectorString == nil {
+		return ""
+	}
+	return *a.VectorString
+}
+
+// GetCWEID returns the CWEID field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWE) GetCWEID() string {
+	if a == nil || a.CWEID == nil {
+		return ""
+	}
+	return *a.CWEID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWE) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetValue() string {
+	if a == nil || a.Value == nil {
+		return ""
+	}
+	return *a.Value
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AdvisoryReference) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetFirstPatchedVersion returns the FirstPatchedVersion field.
+func (a *AdvisoryVulnerability) GetFirstPatchedVersion() *FirstPatchedVersion {
+	if a == nil {
+		return nil
+	}
+	return a.FirstPatchedVersion
+}
+
+// GetPackage returns the Package field.
+func (a *AdvisoryVulnerability) GetPackage() *VulnerabilityPackage {
+	if a == nil {
+		return nil
+	}
+	return a.Package
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetSeverity() string {
+	if a == nil || a.Severity == nil {
+		return ""
+	}
+	return *a.Severity
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetVulnerableVersionRange() string {
+	if a == nil || a.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *a.VulnerableVersionRange
+}
+
 // GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
 func (a *Alert) GetClosedAt() Timestamp {
 	if a == nil || a.ClosedAt == nil {
@@ -148,6 +260,14 @@ func (a *Alert) GetOpen() bool {
 	return *a.Open
 }
 
+// GetRepository returns the Repository field.
+func (a *Alert) GetRepository() *Repository {
+	if a == nil {
+		return nil
+	}
+	return a.Repository
+}
+
 // GetRuleDescription returns the RuleDescription field if it's non-nil, zero value otherwise.
 func (a *Alert) GetRuleDescription() string {
 	if a == nil || a.RuleDescription == nil {
@@ -484,6 +604,118 @@ func (a *Attachment) GetTitle() string {
 	return *a.Title
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetAction() string {
+	if a == nil || a.Action == nil {
+		return ""
+	}
+	return *a.Action
+}
+
+// GetActor returns the Actor field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActor() string {
+	if a == nil || a.Actor == nil {
+		return ""
+	}
+	return *a.Actor
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActorID() int64 {
+	if a == nil || a.ActorID == nil {
+		return 0
+	}
+	return *a.ActorID
+}
+
+// GetBusiness returns the Business field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetBusiness() string {
+	if a == nil || a.Business == nil {
+		return ""
+	}
+	return *a.Business
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetCreatedAt() int64 {
+	if a == nil || a.CreatedAt == nil {
+		return 0
+	}
+	return *a.CreatedAt
+}
+
+// GetDocumentID returns the DocumentID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetDocumentID() string {
+	if a == nil || a.DocumentID == nil {
+		return ""
+	}
+	return *a.DocumentID
+}
+
+// GetOrg returns the Org field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrg() string {
+	if a == nil || a.Org == nil {
+		return ""
+	}
+	return *a.Org
+}
+
+// GetOrgID returns the OrgID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrgID() int64 {
+	if a == nil || a.OrgID == nil {
+		return 0
+	}
+	return *a.OrgID
+}
+
+// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetRepo() string {
+	if a == nil || a.Repo == nil {
+		return ""
+	}
+	return *a.Repo
+}
+
+// GetTeam returns the Team field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTeam() string {
+	if a == nil || a.Team == nil {
+		return ""
+	}
+	return *a.Team
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTimestamp() int64 {
+	if a == nil || a.Timestamp == nil {
+		return 0
+	}
+	return *a.Timestamp
+}
+
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUser() string {
+	if a == nil || a.User == nil {
+		return ""
+	}
+	return *a.User
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUserID() int64 {
+	if a == nil || a.UserID == nil {
+		return 0
+	}
+	return *a.UserID
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetVisibility() string {
+	if a == nil || a.Visibility == nil {
+		return ""
+	}
+	return *a.Visibility
+}
+
 // GetApp returns the App field.
 func (a *Authorization) GetApp() *AuthorizationApp {
 	if a == nil {
@@ -756,6 +988,22 @@ func (b *Branch) GetProtected() bool {
 	return *b.Protected
 }
 
+// GetProtection returns the Protection field.
+func (b *Branch) GetProtection() *BranchProtectionSummary {
+	if b == nil {
+		return nil
+	}
+	return b.Protection
+}
+
+// GetProtectionURL returns the ProtectionURL field if it's non-nil, zero value otherwise.
+func (b *Branch) GetProtectionURL() string {
+	if b == nil || b.ProtectionURL == nil {
+		return ""
+	}
+	return *b.ProtectionURL
+}
+
 // GetCommit returns the Commit field.
 func (b *BranchCommit) GetCommit() *Commit {
 	if b == nil {
@@ -788,6 +1036,70 @@ func (b *BranchListOptions) GetProtected() bool {
 	return *b.Protected
 }
 
+// GetCustomBranchPolicies returns the CustomBranchPolicies field if it's non-nil, zero value otherwise.
+func (b *BranchPolicy) GetCustomBranchPolicies() bool {
+	if b == nil || b.CustomBranchPolicies == nil {
+		return false
+	}
+	return *b.CustomBranchPolicies
+}
+
+// GetProtectedBranches returns the ProtectedBranches field if it's non-nil, zero value otherwise.
+func (b *BranchPolicy) GetProtectedBranches() bool {
+	if b == nil || b.ProtectedBranches == nil {
+		return false
+	}
+	return *b.ProtectedBranches
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionSummary) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
+	}
+	return *b.Enabled
+}
+
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (b *BranchProtectionSummary) GetRequiredStatusChecks() *BranchStatusChecksSummary {
+	if b == nil {
+		return nil
+	}
+	return b.RequiredStatusChecks
+}
+
+// GetEnforcementLevel returns the EnforcementLevel field if it's non-nil, zero value otherwise.
+func (b *BranchStatusChecksSummary) GetEnforcementLevel() string {
+	if b == nil || b.EnforcementLevel == nil {
+		return ""
+	}
+	return *b.EnforcementLevel
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetActorID() int64 {
+	if b == nil || b.ActorID == nil {
+		return 0
+	}
+	return *b.ActorID
+}
+
+// GetActorType returns the ActorType field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetActorType() string {
+	if b == nil || b.ActorType == nil {
+		return ""
+	}
+	return *b.ActorType
+}
+
+// GetBypassMode returns the BypassMode field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetBypassMode() string {
+	if b == nil || b.BypassMode == nil {
+		return ""
+	}
+	return *b.BypassMode
+}
+
 // GetApp returns the App field.
 func (c *CheckRun) GetApp() *App {
 	if c == nil {
@@ -1412,6 +1724,38 @@ func (c *CollaboratorInvitation) GetURL() string {
 	return *c.URL
 }
 
+// GetActor returns the Actor field.
+func (c *CollaboratorPermissionChange) GetActor() *User {
+	if c == nil {
+		return nil
+	}
+	return c.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CollaboratorPermissionChange) GetCreatedAt() time.Time {
+	if c == nil || c.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *c.CreatedAt
+}
+
+// GetTeam returns the Team field.
+func (c *CollaboratorPermissionChange) GetTeam() *Team {
+	if c == nil {
+		return nil
+	}
+	return c.Team
+}
+
+// GetUser returns the User field.
+func (c *CollaboratorPermissionChange) GetUser() *User {
+	if c == nil {
+		return nil
+	}
+	return c.User
+}
+
 // GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
 func (c *CombinedStatus) GetCommitURL() string {
 	if c == nil || c.CommitURL == nil {
@@ -2412,56 +2756,344 @@ func (c *CreateUserProjectOptions) GetBody() string {
 	return *c.Body
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeleteEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
+		return ""
 	}
-	return d.Installation
+	return *c.BaseRole
 }
 
-// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetPusherType() string {
-	if d == nil || d.PusherType == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.PusherType
+	return *c.CreatedAt
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRef() string {
-	if d == nil || d.Ref == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *d.Ref
+	return *c.Description
 }
 
-// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRefType() string {
-	if d == nil || d.RefType == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *d.RefType
+	return *c.ID
 }
 
-// GetRepo returns the Repo field.
-func (d *DeleteEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return d.Repo
+	return *c.Name
 }
 
-// GetSender returns the Sender field.
-func (d *DeleteEvent) GetSender() *User {
-	if d == nil {
+// GetOrg returns the Org field.
+func (c *CustomOrgRole) GetOrg() *Organization {
+	if c == nil {
 		return nil
 	}
-	return d.Sender
+	return c.Org
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DeployKeyEvent) GetAction() string {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetSource() string {
+	if c == nil || c.Source == nil {
+		return ""
+	}
+	return *c.Source
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRole) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *c.UpdatedAt
+}
+
+// GetInstallation returns the Installation field.
+func (d *DeleteEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
+	}
+	return d.Installation
+}
+
+// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetPusherType() string {
+	if d == nil || d.PusherType == nil {
+		return ""
+	}
+	return *d.PusherType
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
+	}
+	return *d.Ref
+}
+
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRefType() string {
+	if d == nil || d.RefType == nil {
+		return ""
+	}
+	return *d.RefType
+}
+
+// GetRepo returns the Repo field.
+func (d *DeleteEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetSender returns the Sender field.
+func (d *DeleteEvent) GetSender() *User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetAutoDismissedAt returns the AutoDismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetAutoDismissedAt() Timestamp {
+	if d == nil || d.AutoDismissedAt == nil {
+		return Timestamp{}
+	}
+	return *d.AutoDismissedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.CreatedAt
+}
+
+// GetDependency returns the Dependency field.
+func (d *DependabotAlert) GetDependency() *Dependency {
+	if d == nil {
+		return nil
+	}
+	return d.Dependency
+}
+
+// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedAt() Timestamp {
+	if d == nil || d.DismissedAt == nil {
+		return Timestamp{}
+	}
+	return *d.DismissedAt
+}
+
+// GetDismissedBy returns the DismissedBy field.
+func (d *DependabotAlert) GetDismissedBy() *User {
+	if d == nil {
+		return nil
+	}
+	return d.DismissedBy
+}
+
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
+		return ""
+	}
+	return *d.DismissedComment
+}
+
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
+		return ""
+	}
+	return *d.DismissedReason
+}
+
+// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetFixedAt() Timestamp {
+	if d == nil || d.FixedAt == nil {
+		return Timestamp{}
+	}
+	return *d.FixedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
+	}
+	return *d.HTMLURL
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
+	}
+	return *d.Number
+}
+
+// GetRepository returns the Repository field.
+func (d *DependabotAlert) GetRepository() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repository
+}
+
+// GetSecurityAdvisory returns the SecurityAdvisory field.
+func (d *DependabotAlert) GetSecurityAdvisory() *DependabotSecurityAdvisory {
+	if d == nil {
+		return nil
+	}
+	return d.SecurityAdvisory
+}
+
+// GetSecurityVulnerability returns the SecurityVulnerability field.
+func (d *DependabotAlert) GetSecurityVulnerability() *AdvisoryVulnerability {
+	if d == nil {
+		return nil
+	}
+	return d.SecurityVulnerability
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
+	}
+	return *d.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetURL() string {
+	if d == nil || d.URL == nil {
+		return ""
+	}
+	return *d.URL
+}
+
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetCVEID() string {
+	if d == nil || d.CVEID == nil {
+		return ""
+	}
+	return *d.CVEID
+}
+
+// GetCVSS returns the CVSS field.
+func (d *DependabotSecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if d == nil {
+		return nil
+	}
+	return d.CVSS
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
+	}
+	return *d.Description
+}
+
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetGHSAID() string {
+	if d == nil || d.GHSAID == nil {
+		return ""
+	}
+	return *d.GHSAID
+}
+
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetPublishedAt() Timestamp {
+	if d == nil || d.PublishedAt == nil {
+		return Timestamp{}
+	}
+	return *d.PublishedAt
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSeverity() string {
+	if d == nil || d.Severity == nil {
+		return ""
+	}
+	return *d.Severity
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSummary() string {
+	if d == nil || d.Summary == nil {
+		return ""
+	}
+	return *d.Summary
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.UpdatedAt
+}
+
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if d == nil || d.WithdrawnAt == nil {
+		return Timestamp{}
+	}
+	return *d.WithdrawnAt
+}
+
+// GetManifestPath returns the ManifestPath field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetManifestPath() string {
+	if d == nil || d.ManifestPath == nil {
+		return ""
+	}
+	return *d.ManifestPath
+}
+
+// GetPackage returns the Package field.
+func (d *Dependency) GetPackage() *VulnerabilityPackage {
+	if d == nil {
+		return nil
+	}
+	return d.Package
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetScope() string {
+	if d == nil || d.Scope == nil {
+		return ""
+	}
+	return *d.Scope
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeployKeyEvent) GetAction() string {
 	if d == nil || d.Action == nil {
 		return ""
 	}
@@ -2876,6 +3508,22 @@ func (d *DeploymentStatusRequest) GetState() string {
 	return *d.State
 }
 
+// GetDeployment returns the Deployment field.
+func (d *DeploymentWithStatus) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
+	}
+	return d.Deployment
+}
+
+// GetLatestStatus returns the LatestStatus field.
+func (d *DeploymentWithStatus) GetLatestStatus() *DeploymentStatus {
+	if d == nil {
+		return nil
+	}
+	return d.LatestStatus
+}
+
 // GetAuthor returns the Author field.
 func (d *DiscussionComment) GetAuthor() *User {
 	if d == nil {
@@ -3172,41 +3820,113 @@ func (e *Enterprise) GetWebsiteURL() string {
 	return *e.WebsiteURL
 }
 
-// GetActor returns the Actor field.
-func (e *Event) GetActor() *User {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Environment) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetDeploymentBranchPolicy returns the DeploymentBranchPolicy field.
+func (e *Environment) GetDeploymentBranchPolicy() *BranchPolicy {
 	if e == nil {
 		return nil
 	}
-	return e.Actor
+	return e.DeploymentBranchPolicy
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Event) GetCreatedAt() time.Time {
-	if e == nil || e.CreatedAt == nil {
-		return time.Time{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (e *Environment) GetHTMLURL() string {
+	if e == nil || e.HTMLURL == nil {
+		return ""
 	}
-	return *e.CreatedAt
+	return *e.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Event) GetID() string {
+func (e *Environment) GetID() int64 {
 	if e == nil || e.ID == nil {
-		return ""
+		return 0
 	}
 	return *e.ID
 }
 
-// GetOrg returns the Org field.
-func (e *Event) GetOrg() *Organization {
-	if e == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Environment) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
 	}
-	return e.Org
+	return *e.Name
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (e *Event) GetPublic() bool {
-	if e == nil || e.Public == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (e *Environment) GetNodeID() string {
+	if e == nil || e.NodeID == nil {
+		return ""
+	}
+	return *e.NodeID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Environment) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (e *Environment) GetURL() string {
+	if e == nil || e.URL == nil {
+		return ""
+	}
+	return *e.URL
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (e *EnvResponse) GetTotalCount() int {
+	if e == nil || e.TotalCount == nil {
+		return 0
+	}
+	return *e.TotalCount
+}
+
+// GetActor returns the Actor field.
+func (e *Event) GetActor() *User {
+	if e == nil {
+		return nil
+	}
+	return e.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Event) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *e.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Event) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+	return *e.ID
+}
+
+// GetOrg returns the Org field.
+func (e *Event) GetOrg() *Organization {
+	if e == nil {
+		return nil
+	}
+	return e.Org
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (e *Event) GetPublic() bool {
+	if e == nil || e.Public == nil {
 		return false
 	}
 	return *e.Public
@@ -3284,6 +4004,30 @@ func (f *Feeds) GetCurrentUserURL() string {
 	return *f.CurrentUserURL
 }
 
+// GetRepositoryDiscussionsCategoryURL returns the RepositoryDiscussionsCategoryURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetRepositoryDiscussionsCategoryURL() string {
+	if f == nil || f.RepositoryDiscussionsCategoryURL == nil {
+		return ""
+	}
+	return *f.RepositoryDiscussionsCategoryURL
+}
+
+// GetRepositoryDiscussionsURL returns the RepositoryDiscussionsURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetRepositoryDiscussionsURL() string {
+	if f == nil || f.RepositoryDiscussionsURL == nil {
+		return ""
+	}
+	return *f.RepositoryDiscussionsURL
+}
+
+// GetSecurityAdvisoriesURL returns the SecurityAdvisoriesURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetSecurityAdvisoriesURL() string {
+	if f == nil || f.SecurityAdvisoriesURL == nil {
+		return ""
+	}
+	return *f.SecurityAdvisoriesURL
+}
+
 // GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
 func (f *Feeds) GetTimelineURL() string {
 	if f == nil || f.TimelineURL == nil {
@@ -3300,6 +4044,30 @@ func (f *Feeds) GetUserURL() string {
 	return *f.UserURL
 }
 
+// GetBase returns the Base field.
+func (f *FileComparison) GetBase() *RepositoryContent {
+	if f == nil {
+		return nil
+	}
+	return f.Base
+}
+
+// GetHead returns the Head field.
+func (f *FileComparison) GetHead() *RepositoryContent {
+	if f == nil {
+		return nil
+	}
+	return f.Head
+}
+
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (f *FirstPatchedVersion) GetIdentifier() string {
+	if f == nil || f.Identifier == nil {
+		return ""
+	}
+	return *f.Identifier
+}
+
 // GetForkee returns the Forkee field.
 func (f *ForkEvent) GetForkee() *Repository {
 	if f == nil {
@@ -3788,6 +4556,14 @@ func (g *GPGKey) GetKeyID() string {
 	return *g.KeyID
 }
 
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
 // GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
 func (g *GPGKey) GetPrimaryKeyID() int64 {
 	if g == nil || g.PrimaryKeyID == nil {
@@ -3804,6 +4580,22 @@ func (g *GPGKey) GetPublicKey() string {
 	return *g.PublicKey
 }
 
+// GetRawKey returns the RawKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetRawKey() string {
+	if g == nil || g.RawKey == nil {
+		return ""
+	}
+	return *g.RawKey
+}
+
+// GetRevoked returns the Revoked field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetRevoked() bool {
+	if g == nil || g.Revoked == nil {
+		return false
+	}
+	return *g.Revoked
+}
+
 // GetApp returns the App field.
 func (g *Grant) GetApp() *AuthorizationApp {
 	if g == nil {
@@ -3940,6 +4732,38 @@ func (h *Hook) GetID() int64 {
 	return *h.ID
 }
 
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *Hook) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
+	}
+	return *h.Name
+}
+
+// GetPingURL returns the PingURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetPingURL() string {
+	if h == nil || h.PingURL == nil {
+		return ""
+	}
+	return *h.PingURL
+}
+
+// GetTestURL returns the TestURL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetTestURL() string {
+	if h == nil || h.TestURL == nil {
+		return ""
+	}
+	return *h.TestURL
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (h *Hook) GetType() string {
+	if h == nil || h.Type == nil {
+		return ""
+	}
+	return *h.Type
+}
+
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
 func (h *Hook) GetUpdatedAt() time.Time {
 	if h == nil || h.UpdatedAt == nil {
@@ -3956,6 +4780,38 @@ func (h *Hook) GetURL() string {
 	return *h.URL
 }
 
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetContentType() string {
+	if h == nil || h.ContentType == nil {
+		return ""
+	}
+	return *h.ContentType
+}
+
+// GetInsecureSSL returns the InsecureSSL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetInsecureSSL() string {
+	if h == nil || h.InsecureSSL == nil {
+		return ""
+	}
+	return *h.InsecureSSL
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetSecret() string {
+	if h == nil || h.Secret == nil {
+		return ""
+	}
+	return *h.Secret
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
 // GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
 func (h *HookStats) GetActiveHooks() int {
 	if h == nil || h.ActiveHooks == nil {
@@ -3980,159 +4836,383 @@ func (h *HookStats) GetTotalHooks() int {
 	return *h.TotalHooks
 }
 
-// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupDescription() string {
-	if i == nil || i.GroupDescription == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
 	}
-	return *i.GroupDescription
+	return *h.ID
 }
 
-// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupID() string {
-	if i == nil || i.GroupID == nil {
-		return ""
+// GetImageDetails returns the ImageDetails field.
+func (h *HostedRunner) GetImageDetails() *HostedRunnerImageDetail {
+	if h == nil {
+		return nil
 	}
-	return *i.GroupID
+	return h.ImageDetails
 }
 
-// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupName() string {
-	if i == nil || i.GroupName == nil {
-		return ""
+// GetLastActiveOn returns the LastActiveOn field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetLastActiveOn() Timestamp {
+	if h == nil || h.LastActiveOn == nil {
+		return Timestamp{}
 	}
-	return *i.GroupName
+	return *h.LastActiveOn
 }
 
-// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsCount() int {
-	if i == nil || i.AuthorsCount == nil {
-		return 0
+// GetMachineSizeDetails returns the MachineSizeDetails field.
+func (h *HostedRunner) GetMachineSizeDetails() *HostedRunnerMachineSpec {
+	if h == nil {
+		return nil
 	}
-	return *i.AuthorsCount
+	return h.MachineSizeDetails
 }
 
-// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsURL() string {
-	if i == nil || i.AuthorsURL == nil {
-		return ""
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetMaximumRunners() int64 {
+	if h == nil || h.MaximumRunners == nil {
+		return 0
 	}
-	return *i.AuthorsURL
+	return *h.MaximumRunners
 }
 
-// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetCommitCount() int {
-	if i == nil || i.CommitCount == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
 	}
-	return *i.CommitCount
+	return *h.Name
 }
 
-// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
-func (i *Import) GetFailedStep() string {
-	if i == nil || i.FailedStep == nil {
+// GetPlatform returns the Platform field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetPlatform() string {
+	if h == nil || h.Platform == nil {
 		return ""
 	}
-	return *i.FailedStep
+	return *h.Platform
 }
 
-// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
-func (i *Import) GetHasLargeFiles() bool {
-	if i == nil || i.HasLargeFiles == nil {
+// GetPublicIPEnabled returns the PublicIPEnabled field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetPublicIPEnabled() bool {
+	if h == nil || h.PublicIPEnabled == nil {
 		return false
 	}
-	return *i.HasLargeFiles
+	return *h.PublicIPEnabled
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetRunnerGroupID() int64 {
+	if h == nil || h.RunnerGroupID == nil {
+		return 0
 	}
-	return *i.HTMLURL
+	return *h.RunnerGroupID
 }
 
-// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
-func (i *Import) GetHumanName() string {
-	if i == nil || i.HumanName == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetStatus() string {
+	if h == nil || h.Status == nil {
 		return ""
 	}
-	return *i.HumanName
+	return *h.Status
 }
 
-// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesCount() int {
-	if i == nil || i.LargeFilesCount == nil {
-		return 0
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetDisplayName() string {
+	if h == nil || h.DisplayName == nil {
+		return ""
 	}
-	return *i.LargeFilesCount
+	return *h.DisplayName
 }
 
-// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesSize() int {
-	if i == nil || i.LargeFilesSize == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
 	}
-	return *i.LargeFilesSize
+	return *h.ID
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *Import) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetPlatform returns the Platform field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetPlatform() string {
+	if h == nil || h.Platform == nil {
 		return ""
 	}
-	return *i.Message
+	return *h.Platform
 }
 
-// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPercent() int {
-	if i == nil || i.Percent == nil {
+// GetSizeGB returns the SizeGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetSizeGB() int64 {
+	if h == nil || h.SizeGB == nil {
 		return 0
 	}
-	return *i.Percent
+	return *h.SizeGB
 }
 
-// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPushPercent() int {
-	if i == nil || i.PushPercent == nil {
-		return 0
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetSource() string {
+	if h == nil || h.Source == nil {
+		return ""
 	}
-	return *i.PushPercent
+	return *h.Source
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetDisplayName() string {
+	if h == nil || h.DisplayName == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *h.DisplayName
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatus() string {
-	if i == nil || i.Status == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetID() string {
+	if h == nil || h.ID == nil {
 		return ""
 	}
-	return *i.Status
+	return *h.ID
 }
 
-// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatusText() string {
-	if i == nil || i.StatusText == nil {
-		return ""
+// GetSizeGB returns the SizeGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetSizeGB() int64 {
+	if h == nil || h.SizeGB == nil {
+		return 0
 	}
-	return *i.StatusText
+	return *h.SizeGB
 }
 
-// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
-func (i *Import) GetTFVCProject() string {
-	if i == nil || i.TFVCProject == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetSource() string {
+	if h == nil || h.Source == nil {
 		return ""
 	}
-	return *i.TFVCProject
+	return *h.Source
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
+// GetCPUCores returns the CPUCores field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetCPUCores() int {
+	if h == nil || h.CPUCores == nil {
+		return 0
+	}
+	return *h.CPUCores
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetMemoryGB returns the MemoryGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetMemoryGB() int {
+	if h == nil || h.MemoryGB == nil {
+		return 0
+	}
+	return *h.MemoryGB
+}
+
+// GetStorageGB returns the StorageGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetStorageGB() int {
+	if h == nil || h.StorageGB == nil {
+		return 0
+	}
+	return *h.StorageGB
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetEnabled() bool {
+	if h == nil || h.Enabled == nil {
+		return false
+	}
+	return *h.Enabled
+}
+
+// GetLength returns the Length field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetLength() int {
+	if h == nil || h.Length == nil {
+		return 0
+	}
+	return *h.Length
+}
+
+// GetPrefix returns the Prefix field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetPrefix() string {
+	if h == nil || h.Prefix == nil {
+		return ""
+	}
+	return *h.Prefix
+}
+
+// GetApp returns the App field.
+func (i *Identity) GetApp() *App {
+	if i == nil {
+		return nil
+	}
+	return i.App
+}
+
+// GetUser returns the User field.
+func (i *Identity) GetUser() *User {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupDescription() string {
+	if i == nil || i.GroupDescription == nil {
+		return ""
+	}
+	return *i.GroupDescription
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupID() string {
+	if i == nil || i.GroupID == nil {
+		return ""
+	}
+	return *i.GroupID
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupName() string {
+	if i == nil || i.GroupName == nil {
+		return ""
+	}
+	return *i.GroupName
+}
+
+// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsCount() int {
+	if i == nil || i.AuthorsCount == nil {
+		return 0
+	}
+	return *i.AuthorsCount
+}
+
+// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsURL() string {
+	if i == nil || i.AuthorsURL == nil {
+		return ""
+	}
+	return *i.AuthorsURL
+}
+
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetCommitCount() int {
+	if i == nil || i.CommitCount == nil {
+		return 0
+	}
+	return *i.CommitCount
+}
+
+// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
+func (i *Import) GetFailedStep() string {
+	if i == nil || i.FailedStep == nil {
+		return ""
+	}
+	return *i.FailedStep
+}
+
+// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
+func (i *Import) GetHasLargeFiles() bool {
+	if i == nil || i.HasLargeFiles == nil {
+		return false
+	}
+	return *i.HasLargeFiles
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
+func (i *Import) GetHumanName() string {
+	if i == nil || i.HumanName == nil {
+		return ""
+	}
+	return *i.HumanName
+}
+
+// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesCount() int {
+	if i == nil || i.LargeFilesCount == nil {
+		return 0
+	}
+	return *i.LargeFilesCount
+}
+
+// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesSize() int {
+	if i == nil || i.LargeFilesSize == nil {
+		return 0
+	}
+	return *i.LargeFilesSize
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *Import) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPercent() int {
+	if i == nil || i.Percent == nil {
+		return 0
+	}
+	return *i.Percent
+}
+
+// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPushPercent() int {
+	if i == nil || i.PushPercent == nil {
+		return 0
+	}
+	return *i.PushPercent
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatusText() string {
+	if i == nil || i.StatusText == nil {
+		return ""
+	}
+	return *i.StatusText
+}
+
+// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
+func (i *Import) GetTFVCProject() string {
+	if i == nil || i.TFVCProject == nil {
+		return ""
+	}
+	return *i.TFVCProject
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (i *Import) GetURL() string {
 	if i == nil || i.URL == nil {
 		return ""
@@ -5340,6 +6420,14 @@ func (i *IssueImportResponse) GetURL() string {
 	return *i.URL
 }
 
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *IssueLabelsAndAssignees) GetNumber() int {
+	if i == nil || i.Number == nil {
+		return 0
+	}
+	return *i.Number
+}
+
 // GetSince returns the Since field if it's non-nil, zero value otherwise.
 func (i *IssueListCommentsOptions) GetSince() time.Time {
 	if i == nil || i.Since == nil {
@@ -5516,6 +6604,14 @@ func (j *Jobs) GetTotalCount() int {
 	return *j.TotalCount
 }
 
+// GetAddedBy returns the AddedBy field if it's non-nil, zero value otherwise.
+func (k *Key) GetAddedBy() string {
+	if k == nil || k.AddedBy == nil {
+		return ""
+	}
+	return *k.AddedBy
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (k *Key) GetCreatedAt() Timestamp {
 	if k == nil || k.CreatedAt == nil {
@@ -5540,6 +6636,14 @@ func (k *Key) GetKey() string {
 	return *k.Key
 }
 
+// GetLastUsed returns the LastUsed field if it's non-nil, zero value otherwise.
+func (k *Key) GetLastUsed() Timestamp {
+	if k == nil || k.LastUsed == nil {
+		return Timestamp{}
+	}
+	return *k.LastUsed
+}
+
 // GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
 func (k *Key) GetReadOnly() bool {
 	if k == nil || k.ReadOnly == nil {
@@ -5564,6 +6668,14 @@ func (k *Key) GetURL() string {
 	return *k.URL
 }
 
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (k *Key) GetVerified() bool {
+	if k == nil || k.Verified == nil {
+		return false
+	}
+	return *k.Verified
+}
+
 // GetColor returns the Color field if it's non-nil, zero value otherwise.
 func (l *Label) GetColor() string {
 	if l == nil || l.Color == nil {
@@ -6252,6 +7364,14 @@ func (m *MemberEvent) GetAction() string {
 	return *m.Action
 }
 
+// GetChanges returns the Changes field.
+func (m *MemberEvent) GetChanges() *MemberChanges {
+	if m == nil {
+		return nil
+	}
+	return m.Changes
+}
+
 // GetInstallation returns the Installation field.
 func (m *MemberEvent) GetInstallation() *Installation {
 	if m == nil {
@@ -6388,6 +7508,22 @@ func (m *MembershipEvent) GetTeam() *Team {
 	return m.Team
 }
 
+// GetErrorResponse returns the ErrorResponse field.
+func (m *MergeConflictError) GetErrorResponse() *ErrorResponse {
+	if m == nil {
+		return nil
+	}
+	return m.ErrorResponse
+}
+
+// GetErrorResponse returns the ErrorResponse field.
+func (m *MergeRefNotFoundError) GetErrorResponse() *ErrorResponse {
+	if m == nil {
+		return nil
+	}
+	return m.ErrorResponse
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (m *MetaEvent) GetAction() string {
 	if m == nil || m.Action == nil {
@@ -6460,6 +7596,38 @@ func (m *Migration) GetExcludeAttachments() bool {
 	return *m.ExcludeAttachments
 }
 
+// GetExcludeGitData returns the ExcludeGitData field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeGitData() bool {
+	if m == nil || m.ExcludeGitData == nil {
+		return false
+	}
+	return *m.ExcludeGitData
+}
+
+// GetExcludeMetadata returns the ExcludeMetadata field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeMetadata() bool {
+	if m == nil || m.ExcludeMetadata == nil {
+		return false
+	}
+	return *m.ExcludeMetadata
+}
+
+// GetExcludeOwnerProjects returns the ExcludeOwnerProjects field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeOwnerProjects() bool {
+	if m == nil || m.ExcludeOwnerProjects == nil {
+		return false
+	}
+	return *m.ExcludeOwnerProjects
+}
+
+// GetExcludeReleases returns the ExcludeReleases field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeReleases() bool {
+	if m == nil || m.ExcludeReleases == nil {
+		return false
+	}
+	return *m.ExcludeReleases
+}
+
 // GetGUID returns the GUID field if it's non-nil, zero value otherwise.
 func (m *Migration) GetGUID() string {
 	if m == nil || m.GUID == nil {
@@ -6484,6 +7652,14 @@ func (m *Migration) GetLockRepositories() bool {
 	return *m.LockRepositories
 }
 
+// GetOrgMetadataOnly returns the OrgMetadataOnly field if it's non-nil, zero value otherwise.
+func (m *Migration) GetOrgMetadataOnly() bool {
+	if m == nil || m.OrgMetadataOnly == nil {
+		return false
+	}
+	return *m.OrgMetadataOnly
+}
+
 // GetState returns the State field if it's non-nil, zero value otherwise.
 func (m *Migration) GetState() string {
 	if m == nil || m.State == nil {
@@ -6932,7 +8108,15 @@ func (o *OAuthAPP) GetURL() string {
 	return *o.URL
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+// GetUseDefault returns the UseDefault field if it's non-nil, zero value otherwise.
+func (o *OIDCSubjectClaimCustomTemplate) GetUseDefault() bool {
+	if o == nil || o.UseDefault == nil {
+		return false
+	}
+	return *o.UseDefault
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (o *Organization) GetAvatarURL() string {
 	if o == nil || o.AvatarURL == nil {
 		return ""
@@ -7156,6 +8340,14 @@ func (o *Organization) GetMembersCanCreateRepos() bool {
 	return *o.MembersCanCreateRepos
 }
 
+// GetMembersCanForkPrivateRepos returns the MembersCanForkPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanForkPrivateRepos() bool {
+	if o == nil || o.MembersCanForkPrivateRepos == nil {
+		return false
+	}
+	return *o.MembersCanForkPrivateRepos
+}
+
 // GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
 func (o *Organization) GetMembersURL() string {
 	if o == nil || o.MembersURL == nil {
@@ -7284,6 +8476,14 @@ func (o *Organization) GetURL() string {
 	return *o.URL
 }
 
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationCustomRoles) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (o *OrganizationEvent) GetAction() string {
 	if o == nil || o.Action == nil {
@@ -7380,6 +8580,62 @@ func (o *OrgBlockEvent) GetSender() *User {
 	return o.Sender
 }
 
+// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
+func (o *OrgRepositoryDefaultSettings) GetDefaultRepoPermission() string {
+	if o == nil || o.DefaultRepoPermission == nil {
+		return ""
+	}
+	return *o.DefaultRepoPermission
+}
+
+// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
+func (o *OrgRepositoryDefaultSettings) GetMembersCanCreateInternalRepos() bool {
+	if o == nil || o.MembersCanCreateInternalRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreateInternalRepos
+}
+
+// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
+func (o *OrgRepositoryDefaultSettings) GetMembersCanCreatePrivateRepos() bool {
+	if o == nil || o.MembersCanCreatePrivateRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreatePrivateRepos
+}
+
+// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
+func (o *OrgRepositoryDefaultSettings) GetMembersCanCreatePublicRepos() bool {
+	if o == nil || o.MembersCanCreatePublicRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreatePublicRepos
+}
+
+// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
+func (o *OrgRepositoryDefaultSettings) GetMembersCanCreateRepos() bool {
+	if o == nil || o.MembersCanCreateRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreateRepos
+}
+
+// GetMembersCanForkPrivateRepos returns the MembersCanForkPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *OrgRepositoryDefaultSettings) GetMembersCanForkPrivateRepos() bool {
+	if o == nil || o.MembersCanForkPrivateRepos == nil {
+		return false
+	}
+	return *o.MembersCanForkPrivateRepos
+}
+
+// GetRole returns the Role field.
+func (o *OrgRoleAssignments) GetRole() *CustomOrgRole {
+	if o == nil {
+		return nil
+	}
+	return o.Role
+}
+
 // GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
 func (o *OrgStats) GetDisabledOrgs() int {
 	if o == nil || o.DisabledOrgs == nil {
@@ -7420,6 +8676,22 @@ func (p *Package) GetCreatedAt() Timestamp {
 	return *p.CreatedAt
 }
 
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Package) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (p *Package) GetEcosystem() string {
+	if p == nil || p.Ecosystem == nil {
+		return ""
+	}
+	return *p.Ecosystem
+}
+
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
 func (p *Package) GetHTMLURL() string {
 	if p == nil || p.HTMLURL == nil {
@@ -7444,6 +8716,14 @@ func (p *Package) GetName() string {
 	return *p.Name
 }
 
+// GetNamespace returns the Namespace field if it's non-nil, zero value otherwise.
+func (p *Package) GetNamespace() string {
+	if p == nil || p.Namespace == nil {
+		return ""
+	}
+	return *p.Namespace
+}
+
 // GetOwner returns the Owner field.
 func (p *Package) GetOwner() *User {
 	if p == nil {
@@ -7972,6 +9252,14 @@ func (p *PageBuildEvent) GetSender() *User {
 	return p.Sender
 }
 
+// GetBuildType returns the BuildType field if it's non-nil, zero value otherwise.
+func (p *Pages) GetBuildType() string {
+	if p == nil || p.BuildType == nil {
+		return ""
+	}
+	return *p.BuildType
+}
+
 // GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
 func (p *Pages) GetCNAME() string {
 	if p == nil || p.CNAME == nil {
@@ -7996,6 +9284,22 @@ func (p *Pages) GetHTMLURL() string {
 	return *p.HTMLURL
 }
 
+// GetHTTPSEnforced returns the HTTPSEnforced field if it's non-nil, zero value otherwise.
+func (p *Pages) GetHTTPSEnforced() bool {
+	if p == nil || p.HTTPSEnforced == nil {
+		return false
+	}
+	return *p.HTTPSEnforced
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *Pages) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
+	}
+	return *p.Public
+}
+
 // GetSource returns the Source field.
 func (p *Pages) GetSource() *PagesSource {
 	if p == nil {
@@ -8116,6 +9420,14 @@ func (p *PageStats) GetTotalPages() int {
 	return *p.TotalPages
 }
 
+// GetBuildType returns the BuildType field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetBuildType() string {
+	if p == nil || p.BuildType == nil {
+		return ""
+	}
+	return *p.BuildType
+}
+
 // GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
 func (p *PagesUpdate) GetCNAME() string {
 	if p == nil || p.CNAME == nil {
@@ -8124,6 +9436,14 @@ func (p *PagesUpdate) GetCNAME() string {
 	return *p.CNAME
 }
 
+// GetHTTPSEnforced returns the HTTPSEnforced field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetHTTPSEnforced() bool {
+	if p == nil || p.HTTPSEnforced == nil {
+		return false
+	}
+	return *p.HTTPSEnforced
+}
+
 // GetSource returns the Source field if it's non-nil, zero value otherwise.
 func (p *PagesUpdate) GetSource() string {
 	if p == nil || p.Source == nil {
@@ -8132,6 +9452,14 @@ func (p *PagesUpdate) GetSource() string {
 	return *p.Source
 }
 
+// GetErrorResponse returns the ErrorResponse field.
+func (p *PathNotFoundError) GetErrorResponse() *ErrorResponse {
+	if p == nil {
+		return nil
+	}
+	return p.ErrorResponse
+}
+
 // GetHook returns the Hook field.
 func (p *PingEvent) GetHook() *Hook {
 	if p == nil {
@@ -8868,162 +10196,450 @@ func (p *ProjectPermissionLevel) GetUser() *User {
 	return p.User
 }
 
-// GetAllowDeletions returns the AllowDeletions field.
-func (p *Protection) GetAllowDeletions() *AllowDeletions {
-	if p == nil {
-		return nil
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetClosed() bool {
+	if p == nil || p.Closed == nil {
+		return false
 	}
-	return p.AllowDeletions
+	return *p.Closed
 }
 
-// GetAllowForcePushes returns the AllowForcePushes field.
-func (p *Protection) GetAllowForcePushes() *AllowForcePushes {
-	if p == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return p.AllowForcePushes
+	return *p.CreatedAt
 }
 
-// GetEnforceAdmins returns the EnforceAdmins field.
-func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return p.EnforceAdmins
+	return *p.ID
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
-	if p == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return p.RequiredPullRequestReviews
+	return *p.Number
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
-	if p == nil {
-		return nil
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
 	}
-	return p.RequiredStatusChecks
+	return *p.Public
 }
 
-// GetRequireLinearHistory returns the RequireLinearHistory field.
-func (p *Protection) GetRequireLinearHistory() *RequireLinearHistory {
-	if p == nil {
-		return nil
+// GetShortDescription returns the ShortDescription field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetShortDescription() string {
+	if p == nil || p.ShortDescription == nil {
+		return ""
 	}
-	return p.RequireLinearHistory
+	return *p.ShortDescription
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *Protection) GetRestrictions() *BranchRestrictions {
-	if p == nil {
-		return nil
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
 	}
-	return p.Restrictions
+	return *p.Title
 }
 
-// GetAllowDeletions returns the AllowDeletions field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetAllowDeletions() bool {
-	if p == nil || p.AllowDeletions == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.AllowDeletions
+	return *p.UpdatedAt
 }
 
-// GetAllowForcePushes returns the AllowForcePushes field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetAllowForcePushes() bool {
-	if p == nil || p.AllowForcePushes == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *p.AllowForcePushes
+	return *p.URL
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return p.RequiredPullRequestReviews
+	return *p.ID
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
-	if p == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return p.RequiredStatusChecks
+	return *p.Name
 }
 
-// GetRequireLinearHistory returns the RequireLinearHistory field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetRequireLinearHistory() bool {
-	if p == nil || p.RequireLinearHistory == nil {
-		return false
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetDate() string {
+	if p == nil || p.Date == nil {
+		return ""
 	}
-	return *p.RequireLinearHistory
+	return *p.Date
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetIterationID returns the IterationID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetIterationID() string {
+	if p == nil || p.IterationID == nil {
+		return ""
 	}
-	return p.Restrictions
+	return *p.IterationID
 }
 
-// GetInstallation returns the Installation field.
-func (p *PublicEvent) GetInstallation() *Installation {
+// GetNumber returns the Number field.
+func (p *ProjectV2FieldValue) GetNumber() *float64 {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.Number
 }
 
-// GetRepo returns the Repo field.
-func (p *PublicEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetSingleSelectOptionID returns the SingleSelectOptionID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetSingleSelectOptionID() string {
+	if p == nil || p.SingleSelectOptionID == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.SingleSelectOptionID
 }
 
-// GetSender returns the Sender field.
-func (p *PublicEvent) GetSender() *User {
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetText() string {
+	if p == nil || p.Text == nil {
+		return ""
+	}
+	return *p.Text
+}
+
+// GetContent returns the Content field.
+func (p *ProjectV2Item) GetContent() *ProjectV2ItemContent {
 	if p == nil {
 		return nil
 	}
-	return p.Sender
+	return p.Content
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (p *PublicKey) GetKey() string {
-	if p == nil || p.Key == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetID() string {
+	if p == nil || p.ID == nil {
 		return ""
 	}
-	return *p.Key
+	return *p.ID
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (p *PublicKey) GetKeyID() string {
-	if p == nil || p.KeyID == nil {
-		return ""
+// GetIsArchived returns the IsArchived field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetIsArchived() bool {
+	if p == nil || p.IsArchived == nil {
+		return false
 	}
-	return *p.KeyID
+	return *p.IsArchived
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetActiveLockReason() string {
-	if p == nil || p.ActiveLockReason == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetType() string {
+	if p == nil || p.Type == nil {
 		return ""
 	}
-	return *p.ActiveLockReason
+	return *p.Type
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAdditions() int {
-	if p == nil || p.Additions == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetTypename returns the Typename field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetTypename() string {
+	if p == nil || p.Typename == nil {
+		return ""
+	}
+	return *p.Typename
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetDate() string {
+	if p == nil || p.Date == nil {
+		return ""
+	}
+	return *p.Date
+}
+
+// GetField returns the Field field.
+func (p *ProjectV2ItemFieldValue) GetField() *ProjectV2Field {
+	if p == nil {
+		return nil
+	}
+	return p.Field
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNumber returns the Number field.
+func (p *ProjectV2ItemFieldValue) GetNumber() *float64 {
+	if p == nil {
+		return nil
+	}
+	return p.Number
+}
+
+// GetOptionID returns the OptionID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetOptionID() string {
+	if p == nil || p.OptionID == nil {
+		return ""
+	}
+	return *p.OptionID
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetText() string {
+	if p == nil || p.Text == nil {
+		return ""
+	}
+	return *p.Text
+}
+
+// GetTypename returns the Typename field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetTypename() string {
+	if p == nil || p.Typename == nil {
+		return ""
+	}
+	return *p.Typename
+}
+
+// GetAllowDeletions returns the AllowDeletions field.
+func (p *Protection) GetAllowDeletions() *AllowDeletions {
+	if p == nil {
+		return nil
+	}
+	return p.AllowDeletions
+}
+
+// GetAllowForcePushes returns the AllowForcePushes field.
+func (p *Protection) GetAllowForcePushes() *AllowForcePushes {
+	if p == nil {
+		return nil
+	}
+	return p.AllowForcePushes
+}
+
+// GetEnforceAdmins returns the EnforceAdmins field.
+func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
+	if p == nil {
+		return nil
+	}
+	return p.EnforceAdmins
+}
+
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
+	if p == nil {
+		return nil
+	}
+	return p.RequiredPullRequestReviews
+}
+
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
+	if p == nil {
+		return nil
+	}
+	return p.RequiredStatusChecks
+}
+
+// GetRequireLinearHistory returns the RequireLinearHistory field.
+func (p *Protection) GetRequireLinearHistory() *RequireLinearHistory {
+	if p == nil {
+		return nil
+	}
+	return p.RequireLinearHistory
+}
+
+// GetRestrictions returns the Restrictions field.
+func (p *Protection) GetRestrictions() *BranchRestrictions {
+	if p == nil {
+		return nil
+	}
+	return p.Restrictions
+}
+
+// GetAllowDeletions returns the AllowDeletions field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetAllowDeletions() bool {
+	if p == nil || p.AllowDeletions == nil {
+		return false
+	}
+	return *p.AllowDeletions
+}
+
+// GetAllowForcePushes returns the AllowForcePushes field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetAllowForcePushes() bool {
+	if p == nil || p.AllowForcePushes == nil {
+		return false
+	}
+	return *p.AllowForcePushes
+}
+
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
+	if p == nil {
+		return nil
+	}
+	return p.RequiredPullRequestReviews
+}
+
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
+	if p == nil {
+		return nil
+	}
+	return p.RequiredStatusChecks
+}
+
+// GetRequireLinearHistory returns the RequireLinearHistory field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetRequireLinearHistory() bool {
+	if p == nil || p.RequireLinearHistory == nil {
+		return false
+	}
+	return *p.RequireLinearHistory
+}
+
+// GetRestrictions returns the Restrictions field.
+func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
+	if p == nil {
+		return nil
+	}
+	return p.Restrictions
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (p *ProtectionRule) GetWaitTimer() int {
+	if p == nil || p.WaitTimer == nil {
+		return 0
+	}
+	return *p.WaitTimer
+}
+
+// GetInstallation returns the Installation field.
+func (p *PublicEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetRepo returns the Repo field.
+func (p *PublicEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *PublicEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKeyID() string {
+	if p == nil || p.KeyID == nil {
+		return ""
+	}
+	return *p.KeyID
+}
+
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetActiveLockReason() string {
+	if p == nil || p.ActiveLockReason == nil {
+		return ""
+	}
+	return *p.ActiveLockReason
+}
+
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAdditions() int {
+	if p == nil || p.Additions == nil {
+		return 0
 	}
 	return *p.Additions
 }
@@ -9924,12 +11540,36 @@ func (p *PullRequestReviewCommentEvent) GetSender() *User {
 	return p.Sender
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewDismissalRequest) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDecision) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetReviewDecision returns the ReviewDecision field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDecision) GetReviewDecision() string {
+	if p == nil || p.ReviewDecision == nil {
 		return ""
 	}
-	return *p.Message
+	return *p.ReviewDecision
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDecision) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDismissalRequest) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
+	}
+	return *p.Message
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
@@ -10556,6 +12196,14 @@ func (r *RateLimits) GetCore() *Rate {
 	return r.Core
 }
 
+// GetGraphQL returns the GraphQL field.
+func (r *RateLimits) GetGraphQL() *Rate {
+	if r == nil {
+		return nil
+	}
+	return r.GraphQL
+}
+
 // GetSearch returns the Search field.
 func (r *RateLimits) GetSearch() *Rate {
 	if r == nil {
@@ -10724,6 +12372,62 @@ func (r *RegistrationToken) GetToken() string {
 	return *r.Token
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RegistryPackageEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetEnterprise returns the Enterprise field.
+func (r *RegistryPackageEvent) GetEnterprise() *Enterprise {
+	if r == nil {
+		return nil
+	}
+	return r.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (r *RegistryPackageEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetOrganization returns the Organization field.
+func (r *RegistryPackageEvent) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Organization
+}
+
+// GetRegistryPackage returns the RegistryPackage field.
+func (r *RegistryPackageEvent) GetRegistryPackage() *Package {
+	if r == nil {
+		return nil
+	}
+	return r.RegistryPackage
+}
+
+// GetRepository returns the Repository field.
+func (r *RegistryPackageEvent) GetRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repository
+}
+
+// GetSender returns the Sender field.
+func (r *RegistryPackageEvent) GetSender() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
+}
+
 // GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
 func (r *ReleaseAsset) GetBrowserDownloadURL() string {
 	if r == nil || r.BrowserDownloadURL == nil {
@@ -10828,6 +12532,14 @@ func (r *ReleaseAsset) GetURL() string {
 	return *r.URL
 }
 
+// GetAsset returns the Asset field.
+func (r *ReleaseAssetDownload) GetAsset() *ReleaseAsset {
+	if r == nil {
+		return nil
+	}
+	return r.Asset
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (r *ReleaseEvent) GetAction() string {
 	if r == nil || r.Action == nil {
@@ -10868,6 +12580,14 @@ func (r *ReleaseEvent) GetSender() *User {
 	return r.Sender
 }
 
+// GetErrorResponse returns the ErrorResponse field.
+func (r *ReleaseNotFoundError) GetErrorResponse() *ErrorResponse {
+	if r == nil {
+		return nil
+	}
+	return r.ErrorResponse
+}
+
 // GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
 func (r *RemoveToken) GetExpiresAt() Timestamp {
 	if r == nil || r.ExpiresAt == nil {
@@ -10916,6 +12636,102 @@ func (r *RenameOrgResponse) GetURL() string {
 	return *r.URL
 }
 
+// GetBadgeURL returns the BadgeURL field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetBadgeURL() string {
+	if r == nil || r.BadgeURL == nil {
+		return ""
+	}
+	return *r.BadgeURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
+	}
+	return *r.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetPath() string {
+	if r == nil || r.Path == nil {
+		return ""
+	}
+	return *r.Path
+}
+
+// GetSourceRepository returns the SourceRepository field.
+func (r *RepoRequiredWorkflow) GetSourceRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.SourceRepository
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetState() string {
+	if r == nil || r.State == nil {
+		return ""
+	}
+	return *r.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return *r.URL
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflows) GetTotalCount() int {
+	if r == nil || r.TotalCount == nil {
+		return 0
+	}
+	return *r.TotalCount
+}
+
 // GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
 func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
 	if r == nil || r.IncompleteResults == nil {
@@ -12372,6 +14188,102 @@ func (r *RepositoryRelease) GetZipballURL() string {
 	return *r.ZipballURL
 }
 
+// GetParameters returns the Parameters field if it's non-nil, zero value otherwise.
+func (r *RepositoryRule) GetParameters() json.RawMessage {
+	if r == nil || r.Parameters == nil {
+		return json.RawMessage{}
+	}
+	return *r.Parameters
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryRule) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetConditions returns the Conditions field.
+func (r *RepositoryRulesetChanges) GetConditions() *RulesetConditionsChanges {
+	if r == nil {
+		return nil
+	}
+	return r.Conditions
+}
+
+// GetRules returns the Rules field.
+func (r *RepositoryRulesetChanges) GetRules() *RulesetRulesChanges {
+	if r == nil {
+		return nil
+	}
+	return r.Rules
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryRulesetEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetChanges returns the Changes field.
+func (r *RepositoryRulesetEvent) GetChanges() *RepositoryRulesetChanges {
+	if r == nil {
+		return nil
+	}
+	return r.Changes
+}
+
+// GetEnterprise returns the Enterprise field.
+func (r *RepositoryRulesetEvent) GetEnterprise() *Enterprise {
+	if r == nil {
+		return nil
+	}
+	return r.Enterprise
+}
+
+// GetInstallation returns the Installation field.
+func (r *RepositoryRulesetEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetOrganization returns the Organization field.
+func (r *RepositoryRulesetEvent) GetOrganization() *Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Organization
+}
+
+// GetRepository returns the Repository field.
+func (r *RepositoryRulesetEvent) GetRepository() *Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repository
+}
+
+// GetRepositoryRuleset returns the RepositoryRuleset field.
+func (r *RepositoryRulesetEvent) GetRepositoryRuleset() *Ruleset {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryRuleset
+}
+
+// GetSender returns the Sender field.
+func (r *RepositoryRulesetEvent) GetSender() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
+}
+
 // GetCommit returns the Commit field.
 func (r *RepositoryTag) GetCommit() *Commit {
 	if r == nil {
@@ -12481,183 +14393,719 @@ func (r *RepoStatus) GetCreatedAt() time.Time {
 	if r == nil || r.CreatedAt == nil {
 		return time.Time{}
 	}
-	return *r.CreatedAt
+	return *r.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (r *RepoStatus) GetCreator() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
+	}
+	return *r.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetState() string {
+	if r == nil || r.State == nil {
+		return ""
+	}
+	return *r.State
+}
+
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetTargetURL() string {
+	if r == nil || r.TargetURL == nil {
+		return ""
+	}
+	return *r.TargetURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *r.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+	return *r.URL
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RequiredReviewer) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusCheck) GetAppID() int64 {
+	if r == nil || r.AppID == nil {
+		return 0
+	}
+	return *r.AppID
+}
+
+// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusChecksRequest) GetStrict() bool {
+	if r == nil || r.Strict == nil {
+		return false
+	}
+	return *r.Strict
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *ReviewersRequest) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetRoot returns the Root field.
+func (r *ReviewThread) GetRoot() *PullRequestComment {
+	if r == nil {
+		return nil
+	}
+	return r.Root
+}
+
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetDetails() string {
+	if r == nil || r.Details == nil {
+		return ""
+	}
+	return *r.Details
+}
+
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetEnforcement() string {
+	if r == nil || r.Enforcement == nil {
+		return ""
+	}
+	return *r.Enforcement
+}
+
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
+	}
+	return *r.Result
+}
+
+// GetRuleSource returns the RuleSource field.
+func (r *RuleEvaluation) GetRuleSource() *RuleSource {
+	if r == nil {
+		return nil
+	}
+	return r.RuleSource
+}
+
+// GetRuleType returns the RuleType field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetRuleType() string {
+	if r == nil || r.RuleType == nil {
+		return ""
+	}
+	return *r.RuleType
+}
+
+// GetConditions returns the Conditions field.
+func (r *Ruleset) GetConditions() *RulesetConditions {
+	if r == nil {
+		return nil
+	}
+	return r.Conditions
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.CreatedAt
+}
+
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetEnforcement() string {
+	if r == nil || r.Enforcement == nil {
+		return ""
+	}
+	return *r.Enforcement
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetSource() string {
+	if r == nil || r.Source == nil {
+		return ""
+	}
+	return *r.Source
+}
+
+// GetSourceType returns the SourceType field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetSourceType() string {
+	if r == nil || r.SourceType == nil {
+		return ""
+	}
+	return *r.SourceType
+}
+
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetTarget() string {
+	if r == nil || r.Target == nil {
+		return ""
+	}
+	return *r.Target
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.UpdatedAt
+}
+
+// GetRefName returns the RefName field.
+func (r *RulesetConditions) GetRefName() *RulesetRefConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RefName
+}
+
+// GetRepositoryID returns the RepositoryID field.
+func (r *RulesetConditions) GetRepositoryID() *RulesetRepositoryIDsConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryID
+}
+
+// GetRepositoryName returns the RepositoryName field.
+func (r *RulesetConditions) GetRepositoryName() *RulesetRepositoryNamesConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryName
+}
+
+// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
+func (r *RulesetRepositoryNamesConditionParameters) GetProtected() bool {
+	if r == nil || r.Protected == nil {
+		return false
+	}
+	return *r.Protected
+}
+
+// GetCondition returns the Condition field.
+func (r *RulesetUpdatedConditions) GetCondition() *RulesetConditions {
+	if r == nil {
+		return nil
+	}
+	return r.Condition
+}
+
+// GetRule returns the Rule field.
+func (r *RulesetUpdatedRule) GetRule() *RepositoryRule {
+	if r == nil {
+		return nil
+	}
+	return r.Rule
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RuleSource) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RuleSource) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RuleSource) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetActorID() int64 {
+	if r == nil || r.ActorID == nil {
+		return 0
+	}
+	return *r.ActorID
+}
+
+// GetActorName returns the ActorName field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetActorName() string {
+	if r == nil || r.ActorName == nil {
+		return ""
+	}
+	return *r.ActorName
+}
+
+// GetAfterSHA returns the AfterSHA field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetAfterSHA() string {
+	if r == nil || r.AfterSHA == nil {
+		return ""
+	}
+	return *r.AfterSHA
+}
+
+// GetBeforeSHA returns the BeforeSHA field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetBeforeSHA() string {
+	if r == nil || r.BeforeSHA == nil {
+		return ""
+	}
+	return *r.BeforeSHA
+}
+
+// GetEvaluationResult returns the EvaluationResult field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetEvaluationResult() string {
+	if r == nil || r.EvaluationResult == nil {
+		return ""
+	}
+	return *r.EvaluationResult
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetPushedAt() Timestamp {
+	if r == nil || r.PushedAt == nil {
+		return Timestamp{}
+	}
+	return *r.PushedAt
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetRef() string {
+	if r == nil || r.Ref == nil {
+		return ""
+	}
+	return *r.Ref
+}
+
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetRepositoryID() int64 {
+	if r == nil || r.RepositoryID == nil {
+		return 0
+	}
+	return *r.RepositoryID
+}
+
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetRepositoryName() string {
+	if r == nil || r.RepositoryName == nil {
+		return ""
+	}
+	return *r.RepositoryName
+}
+
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
+	}
+	return *r.Result
+}
+
+// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
+func (r *Runner) GetBusy() bool {
+	if r == nil || r.Busy == nil {
+		return false
+	}
+	return *r.Busy
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Runner) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Runner) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *Runner) GetOS() string {
+	if r == nil || r.OS == nil {
+		return ""
+	}
+	return *r.OS
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (r *Runner) GetStatus() string {
+	if r == nil || r.Status == nil {
+		return ""
+	}
+	return *r.Status
+}
+
+// GetArchitecture returns the Architecture field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetArchitecture() string {
+	if r == nil || r.Architecture == nil {
+		return ""
+	}
+	return *r.Architecture
+}
+
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
+		return ""
+	}
+	return *r.DownloadURL
+}
+
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetFilename() string {
+	if r == nil || r.Filename == nil {
+		return ""
+	}
+	return *r.Filename
+}
+
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetOS() string {
+	if r == nil || r.OS == nil {
+		return ""
+	}
+	return *r.OS
+}
+
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetAllowsPublicRepositories() bool {
+	if r == nil || r.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *r.AllowsPublicRepositories
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetDefault() bool {
+	if r == nil || r.Default == nil {
+		return false
+	}
+	return *r.Default
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetInherited returns the Inherited field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetInherited() bool {
+	if r == nil || r.Inherited == nil {
+		return false
+	}
+	return *r.Inherited
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetRestrictedToWorkflows returns the RestrictedToWorkflows field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetRestrictedToWorkflows() bool {
+	if r == nil || r.RestrictedToWorkflows == nil {
+		return false
+	}
+	return *r.RestrictedToWorkflows
+}
+
+// GetRunnersURL returns the RunnersURL field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetRunnersURL() string {
+	if r == nil || r.RunnersURL == nil {
+		return ""
+	}
+	return *r.RunnersURL
+}
+
+// GetSelectedRepositoriesURL returns the SelectedRepositoriesURL field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetSelectedRepositoriesURL() string {
+	if r == nil || r.SelectedRepositoriesURL == nil {
+		return ""
+	}
+	return *r.SelectedRepositoriesURL
 }
 
-// GetCreator returns the Creator field.
-func (r *RepoStatus) GetCreator() *User {
-	if r == nil {
-		return nil
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetVisibility() string {
+	if r == nil || r.Visibility == nil {
+		return ""
 	}
-	return r.Creator
+	return *r.Visibility
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetDescription() string {
-	if r == nil || r.Description == nil {
-		return ""
+// GetWorkflowRestrictionsReadOnly returns the WorkflowRestrictionsReadOnly field if it's non-nil, zero value otherwise.
+func (r *RunnerGroup) GetWorkflowRestrictionsReadOnly() bool {
+	if r == nil || r.WorkflowRestrictionsReadOnly == nil {
+		return false
 	}
-	return *r.Description
+	return *r.WorkflowRestrictionsReadOnly
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetID() int64 {
+func (r *RunnerLabels) GetID() int64 {
 	if r == nil || r.ID == nil {
 		return 0
 	}
 	return *r.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.Name
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetState() string {
-	if r == nil || r.State == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.State
+	return *r.Type
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetTargetURL() string {
-	if r == nil || r.TargetURL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.TargetURL
+	return *s.CreatedAt
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetUpdatedAt() time.Time {
-	if r == nil || r.UpdatedAt == nil {
-		return time.Time{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
+		return ""
 	}
-	return *r.UpdatedAt
+	return *s.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetLocationsURL returns the LocationsURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetLocationsURL() string {
+	if s == nil || s.LocationsURL == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.LocationsURL
 }
 
-// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
-func (r *RequiredStatusChecksRequest) GetStrict() bool {
-	if r == nil || r.Strict == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetNumber() int {
+	if s == nil || s.Number == nil {
+		return 0
+	}
+	return *s.Number
+}
+
+// GetPushProtectionBypassed returns the PushProtectionBypassed field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetPushProtectionBypassed() bool {
+	if s == nil || s.PushProtectionBypassed == nil {
 		return false
 	}
-	return *r.Strict
+	return *s.PushProtectionBypassed
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReviewersRequest) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
-		return ""
+// GetPushProtectionBypassedAt returns the PushProtectionBypassedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetPushProtectionBypassedAt() Timestamp {
+	if s == nil || s.PushProtectionBypassedAt == nil {
+		return Timestamp{}
 	}
-	return *r.NodeID
+	return *s.PushProtectionBypassedAt
 }
 
-// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
-func (r *Runner) GetBusy() bool {
-	if r == nil || r.Busy == nil {
-		return false
+// GetPushProtectionBypassedBy returns the PushProtectionBypassedBy field.
+func (s *SecretScanningAlert) GetPushProtectionBypassedBy() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.Busy
+	return s.PushProtectionBypassedBy
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Runner) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetRepository returns the Repository field.
+func (s *SecretScanningAlert) GetRepository() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.ID
+	return s.Repository
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Runner) GetName() string {
-	if r == nil || r.Name == nil {
+// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolution() string {
+	if s == nil || s.Resolution == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.Resolution
 }
 
-// GetOS returns the OS field if it's non-nil, zero value otherwise.
-func (r *Runner) GetOS() string {
-	if r == nil || r.OS == nil {
+// GetResolutionComment returns the ResolutionComment field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolutionComment() string {
+	if s == nil || s.ResolutionComment == nil {
 		return ""
 	}
-	return *r.OS
+	return *s.ResolutionComment
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (r *Runner) GetStatus() string {
-	if r == nil || r.Status == nil {
-		return ""
+// GetResolvedAt returns the ResolvedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolvedAt() Timestamp {
+	if s == nil || s.ResolvedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Status
+	return *s.ResolvedAt
 }
 
-// GetArchitecture returns the Architecture field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetArchitecture() string {
-	if r == nil || r.Architecture == nil {
+// GetResolvedBy returns the ResolvedBy field.
+func (s *SecretScanningAlert) GetResolvedBy() *User {
+	if s == nil {
+		return nil
+	}
+	return s.ResolvedBy
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecret() string {
+	if s == nil || s.Secret == nil {
 		return ""
 	}
-	return *r.Architecture
+	return *s.Secret
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretType() string {
+	if s == nil || s.SecretType == nil {
 		return ""
 	}
-	return *r.DownloadURL
+	return *s.SecretType
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetFilename() string {
-	if r == nil || r.Filename == nil {
+// GetSecretTypeDisplayName returns the SecretTypeDisplayName field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretTypeDisplayName() string {
+	if s == nil || s.SecretTypeDisplayName == nil {
 		return ""
 	}
-	return *r.Filename
+	return *s.SecretTypeDisplayName
 }
 
-// GetOS returns the OS field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetOS() string {
-	if r == nil || r.OS == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetState() string {
+	if s == nil || s.State == nil {
 		return ""
 	}
-	return *r.OS
+	return *s.State
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.ID
+	return *s.UpdatedAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetName() string {
-	if r == nil || r.Name == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.URL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetType() string {
-	if r == nil || r.Type == nil {
+// GetValidity returns the Validity field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetValidity() string {
+	if s == nil || s.Validity == nil {
 		return ""
 	}
-	return *r.Type
+	return *s.Validity
 }
 
 // GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
@@ -12724,6 +15172,14 @@ func (s *SignatureVerification) GetVerified() bool {
 	return *s.Verified
 }
 
+// GetVerifiedAt returns the VerifiedAt field if it's non-nil, zero value otherwise.
+func (s *SignatureVerification) GetVerifiedAt() Timestamp {
+	if s == nil || s.VerifiedAt == nil {
+		return Timestamp{}
+	}
+	return *s.VerifiedAt
+}
+
 // GetActor returns the Actor field.
 func (s *Source) GetActor() *User {
 	if s == nil {
@@ -13444,6 +15900,14 @@ func (t *TeamDiscussion) GetURL() string {
 	return *t.URL
 }
 
+// GetTeam returns the Team field.
+func (t *TeamEffectivePermission) GetTeam() *Team {
+	if t == nil {
+		return nil
+	}
+	return t.Team
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (t *TeamEvent) GetAction() string {
 	if t == nil || t.Action == nil {
@@ -14084,6 +16548,126 @@ func (u *UpdateCheckRunOptions) GetStatus() string {
 	return *u.Status
 }
 
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetDate() string {
+	if u == nil || u.Date == nil {
+		return ""
+	}
+	return *u.Date
+}
+
+// GetDiscountAmount returns the DiscountAmount field.
+func (u *UsageItem) GetDiscountAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DiscountAmount
+}
+
+// GetGrossAmount returns the GrossAmount field.
+func (u *UsageItem) GetGrossAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.GrossAmount
+}
+
+// GetNetAmount returns the NetAmount field.
+func (u *UsageItem) GetNetAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.NetAmount
+}
+
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetOrganizationName() string {
+	if u == nil || u.OrganizationName == nil {
+		return ""
+	}
+	return *u.OrganizationName
+}
+
+// GetPricePerUnit returns the PricePerUnit field.
+func (u *UsageItem) GetPricePerUnit() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.PricePerUnit
+}
+
+// GetProduct returns the Product field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetProduct() string {
+	if u == nil || u.Product == nil {
+		return ""
+	}
+	return *u.Product
+}
+
+// GetQuantity returns the Quantity field.
+func (u *UsageItem) GetQuantity() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.Quantity
+}
+
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetRepositoryName() string {
+	if u == nil || u.RepositoryName == nil {
+		return ""
+	}
+	return *u.RepositoryName
+}
+
+// GetSKU returns the SKU field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetSKU() string {
+	if u == nil || u.SKU == nil {
+		return ""
+	}
+	return *u.SKU
+}
+
+// GetUnitType returns the UnitType field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetUnitType() string {
+	if u == nil || u.UnitType == nil {
+		return ""
+	}
+	return *u.UnitType
+}
+
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetDay() int {
+	if u == nil || u.Day == nil {
+		return 0
+	}
+	return *u.Day
+}
+
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetHour() int {
+	if u == nil || u.Hour == nil {
+		return 0
+	}
+	return *u.Hour
+}
+
+// GetMonth returns the Month field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetMonth() int {
+	if u == nil || u.Month == nil {
+		return 0
+	}
+	return *u.Month
+}
+
+// GetYear returns the Year field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetYear() int {
+	if u == nil || u.Year == nil {
+		return 0
+	}
+	return *u.Year
+}
+
 // GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (u *User) GetAvatarURL() string {
 	if u == nil || u.AvatarURL == nil {
@@ -14836,6 +17420,22 @@ func (u *UserSuspendOptions) GetReason() string {
 	return *u.Reason
 }
 
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetEcosystem() string {
+	if v == nil || v.Ecosystem == nil {
+		return ""
+	}
+	return *v.Ecosystem
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetName() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (w *WatchEvent) GetAction() string {
 	if w == nil || w.Action == nil {
@@ -15316,6 +17916,14 @@ func (w *WorkflowJob) GetNodeID() string {
 	return *w.NodeID
 }
 
+// GetRunAttempt returns the RunAttempt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunAttempt() int64 {
+	if w == nil || w.RunAttempt == nil {
+		return 0
+	}
+	return *w.RunAttempt
+}
+
 // GetRunID returns the RunID field if it's non-nil, zero value otherwise.
 func (w *WorkflowJob) GetRunID() int64 {
 	if w == nil || w.RunID == nil {
@@ -15324,6 +17932,38 @@ func (w *WorkflowJob) GetRunID() int64 {
 	return *w.RunID
 }
 
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerGroupID() int64 {
+	if w == nil || w.RunnerGroupID == nil {
+		return 0
+	}
+	return *w.RunnerGroupID
+}
+
+// GetRunnerGroupName returns the RunnerGroupName field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerGroupName() string {
+	if w == nil || w.RunnerGroupName == nil {
+		return ""
+	}
+	return *w.RunnerGroupName
+}
+
+// GetRunnerID returns the RunnerID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerID() int64 {
+	if w == nil || w.RunnerID == nil {
+		return 0
+	}
+	return *w.RunnerID
+}
+
+// GetRunnerName returns the RunnerName field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerName() string {
+	if w == nil || w.RunnerName == nil {
+		return ""
+	}
+	return *w.RunnerName
+}
+
 // GetRunURL returns the RunURL field if it's non-nil, zero value otherwise.
 func (w *WorkflowJob) GetRunURL() string {
 	if w == nil || w.RunURL == nil {
@@ -15612,6 +18252,14 @@ func (w *WorkflowRunEvent) GetSender() *User {
 	return w.Sender
 }
 
+// GetErrorResponse returns the ErrorResponse field.
+func (w *WorkflowRunLogsPurgedError) GetErrorResponse() *ErrorResponse {
+	if w == nil {
+		return nil
+	}
+	return w.ErrorResponse
+}
+
 // GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
 func (w *WorkflowRuns) GetTotalCount() int {
 	if w == nil || w.TotalCount == nil {
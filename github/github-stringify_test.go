@@ -13,6 +13,18 @@ import (
 
 func Float64(v float64) *float64 { return &v }
 
+func TestActionsPermissionsRepository_String(t *testing.T) {
+	v := ActionsPermissionsRepository{
+		Enabled:            Bool(false),
+		AllowedActions:     String(""),
+		SelectedActionsURL: String(""),
+	}
+	want := `github.ActionsPermissionsRepository{Enabled:false, AllowedActions:"", SelectedActionsURL:""}`
+	if got := v.String(); got != want {
+		t.Errorf("ActionsPermissionsRepository.String = %v, want %v", got, want)
+	}
+}
+
 func TestAdminStats_String(t *testing.T) {
 	v := AdminStats{
 		Issues:     &IssueStats{},
@@ -32,6 +44,29 @@ func TestAdminStats_String(t *testing.T) {
 	}
 }
 
+func TestAuditEntry_String(t *testing.T) {
+	v := AuditEntry{
+		Timestamp:  Int64(0),
+		DocumentID: String(""),
+		Action:     String(""),
+		Actor:      String(""),
+		ActorID:    Int64(0),
+		Business:   String(""),
+		CreatedAt:  Int64(0),
+		Org:        String(""),
+		OrgID:      Int64(0),
+		Repo:       String(""),
+		Team:       String(""),
+		User:       String(""),
+		UserID:     Int64(0),
+		Visibility: String(""),
+	}
+	want := `github.AuditEntry{Timestamp:0, DocumentID:"", Action:"", Actor:"", ActorID:0, Business:"", CreatedAt:0, Org:"", OrgID:0, Repo:"", Team:"", User:"", UserID:0, Visibility:""}`
+	if got := v.String(); got != want {
+		t.Errorf("AuditEntry.String = %v, want %v", got, want)
+	}
+}
+
 func TestAuthorization_String(t *testing.T) {
 	v := Authorization{
 		ID:             Int64(0),
@@ -273,6 +308,31 @@ func TestContributorStats_String(t *testing.T) {
 	}
 }
 
+func TestDependabotAlert_String(t *testing.T) {
+	v := DependabotAlert{
+		Number:                Int(0),
+		State:                 String(""),
+		Dependency:            &Dependency{},
+		SecurityAdvisory:      &DependabotSecurityAdvisory{},
+		SecurityVulnerability: &AdvisoryVulnerability{},
+		URL:                   String(""),
+		HTMLURL:               String(""),
+		CreatedAt:             &Timestamp{},
+		UpdatedAt:             &Timestamp{},
+		DismissedAt:           &Timestamp{},
+		DismissedBy:           &User{},
+		DismissedReason:       String(""),
+		DismissedComment:      String(""),
+		FixedAt:               &Timestamp{},
+		AutoDismissedAt:       &Timestamp{},
+		Repository:            &Repository{},
+	}
+	want := `github.DependabotAlert{Number:0, State:"", Dependency:github.Dependency{}, SecurityAdvisory:github.DependabotSecurityAdvisory{}, SecurityVulnerability:github.AdvisoryVulnerability{}, URL:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, DismissedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, DismissedBy:github.User{}, DismissedReason:"", DismissedComment:"", FixedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, AutoDismissedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Repository:github.Repository{}}`
+	if got := v.String(); got != want {
+		t.Errorf("DependabotAlert.String = %v, want %v", got, want)
+	}
+}
+
 func TestDiscussionComment_String(t *testing.T) {
 	v := DiscussionComment{
 		Author:        &User{},
@@ -330,6 +390,23 @@ func TestEnterprise_String(t *testing.T) {
 	}
 }
 
+func TestEnvironment_String(t *testing.T) {
+	v := Environment{
+		ID:                     Int64(0),
+		NodeID:                 String(""),
+		Name:                   String(""),
+		URL:                    String(""),
+		HTMLURL:                String(""),
+		CreatedAt:              &Timestamp{},
+		UpdatedAt:              &Timestamp{},
+		DeploymentBranchPolicy: &BranchPolicy{},
+	}
+	want := `github.Environment{ID:0, NodeID:"", Name:"", URL:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, DeploymentBranchPolicy:github.BranchPolicy{}}`
+	if got := v.String(); got != want {
+		t.Errorf("Environment.String = %v, want %v", got, want)
+	}
+}
+
 func TestEvent_String(t *testing.T) {
 	v := Event{
 		Type:   String(""),
@@ -348,6 +425,7 @@ func TestEvent_String(t *testing.T) {
 func TestGPGKey_String(t *testing.T) {
 	v := GPGKey{
 		ID:                Int64(0),
+		Name:              String(""),
 		PrimaryKeyID:      Int64(0),
 		KeyID:             String(""),
 		PublicKey:         String(""),
@@ -355,8 +433,10 @@ func TestGPGKey_String(t *testing.T) {
 		CanEncryptComms:   Bool(false),
 		CanEncryptStorage: Bool(false),
 		CanCertify:        Bool(false),
+		Revoked:           Bool(false),
+		RawKey:            String(""),
 	}
-	want := `github.GPGKey{ID:0, PrimaryKeyID:0, KeyID:"", PublicKey:"", CanSign:false, CanEncryptComms:false, CanEncryptStorage:false, CanCertify:false}`
+	want := `github.GPGKey{ID:0, Name:"", PrimaryKeyID:0, KeyID:"", PublicKey:"", CanSign:false, CanEncryptComms:false, CanEncryptStorage:false, CanCertify:false, Revoked:false, RawKey:""}`
 	if got := v.String(); got != want {
 		t.Errorf("GPGKey.String = %v, want %v", got, want)
 	}
@@ -508,17 +588,34 @@ func TestHeadCommit_String(t *testing.T) {
 
 func TestHook_String(t *testing.T) {
 	v := Hook{
-		URL:    String(""),
-		ID:     Int64(0),
-		Config: nil,
-		Active: Bool(false),
+		URL:     String(""),
+		ID:      Int64(0),
+		Type:    String(""),
+		Name:    String(""),
+		TestURL: String(""),
+		PingURL: String(""),
+		Config:  nil,
+		Active:  Bool(false),
 	}
-	want := `github.Hook{URL:"", ID:0, Config:map[], Active:false}`
+	want := `github.Hook{URL:"", ID:0, Type:"", Name:"", TestURL:"", PingURL:"", Config:map[], Active:false}`
 	if got := v.String(); got != want {
 		t.Errorf("Hook.String = %v, want %v", got, want)
 	}
 }
 
+func TestHookConfig_String(t *testing.T) {
+	v := HookConfig{
+		URL:         String(""),
+		ContentType: String(""),
+		InsecureSSL: String(""),
+		Secret:      String(""),
+	}
+	want := `github.HookConfig{URL:"", ContentType:"", InsecureSSL:"", Secret:""}`
+	if got := v.String(); got != want {
+		t.Errorf("HookConfig.String = %v, want %v", got, want)
+	}
+}
+
 func TestHookStats_String(t *testing.T) {
 	v := HookStats{
 		TotalHooks:    Int(0),
@@ -531,6 +628,38 @@ func TestHookStats_String(t *testing.T) {
 	}
 }
 
+func TestHostedRunner_String(t *testing.T) {
+	v := HostedRunner{
+		ID:                 Int64(0),
+		Name:               String(""),
+		RunnerGroupID:      Int64(0),
+		Platform:           String(""),
+		ImageDetails:       &HostedRunnerImageDetail{},
+		MachineSizeDetails: &HostedRunnerMachineSpec{},
+		Status:             String(""),
+		MaximumRunners:     Int64(0),
+		PublicIPEnabled:    Bool(false),
+		LastActiveOn:       &Timestamp{},
+	}
+	want := `github.HostedRunner{ID:0, Name:"", RunnerGroupID:0, Platform:"", ImageDetails:github.HostedRunnerImageDetail{}, MachineSizeDetails:github.HostedRunnerMachineSpec{}, Status:"", MaximumRunners:0, PublicIPEnabled:false, LastActiveOn:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	if got := v.String(); got != want {
+		t.Errorf("HostedRunner.String = %v, want %v", got, want)
+	}
+}
+
+func TestHostedRunnerMachineSpec_String(t *testing.T) {
+	v := HostedRunnerMachineSpec{
+		ID:        String(""),
+		CPUCores:  Int(0),
+		MemoryGB:  Int(0),
+		StorageGB: Int(0),
+	}
+	want := `github.HostedRunnerMachineSpec{ID:"", CPUCores:0, MemoryGB:0, StorageGB:0}`
+	if got := v.String(); got != want {
+		t.Errorf("HostedRunnerMachineSpec.String = %v, want %v", got, want)
+	}
+}
+
 func TestImport_String(t *testing.T) {
 	v := Import{
 		VCSURL:          String(""),
@@ -672,8 +801,11 @@ func TestKey_String(t *testing.T) {
 		Title:     String(""),
 		ReadOnly:  Bool(false),
 		CreatedAt: &Timestamp{},
+		Verified:  Bool(false),
+		AddedBy:   String(""),
+		LastUsed:  &Timestamp{},
 	}
-	want := `github.Key{ID:0, Key:"", URL:"", Title:"", ReadOnly:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	want := `github.Key{ID:0, Key:"", URL:"", Title:"", ReadOnly:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Verified:false, AddedBy:"", LastUsed:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
 	if got := v.String(); got != want {
 		t.Errorf("Key.String = %v, want %v", got, want)
 	}
@@ -759,16 +891,21 @@ func TestMembership_String(t *testing.T) {
 
 func TestMigration_String(t *testing.T) {
 	v := Migration{
-		ID:                 Int64(0),
-		GUID:               String(""),
-		State:              String(""),
-		LockRepositories:   Bool(false),
-		ExcludeAttachments: Bool(false),
-		URL:                String(""),
-		CreatedAt:          String(""),
-		UpdatedAt:          String(""),
-	}
-	want := `github.Migration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, URL:"", CreatedAt:"", UpdatedAt:""}`
+		ID:                   Int64(0),
+		GUID:                 String(""),
+		State:                String(""),
+		LockRepositories:     Bool(false),
+		ExcludeAttachments:   Bool(false),
+		ExcludeReleases:      Bool(false),
+		ExcludeOwnerProjects: Bool(false),
+		ExcludeMetadata:      Bool(false),
+		ExcludeGitData:       Bool(false),
+		OrgMetadataOnly:      Bool(false),
+		URL:                  String(""),
+		CreatedAt:            String(""),
+		UpdatedAt:            String(""),
+	}
+	want := `github.Migration{ID:0, GUID:"", State:"", LockRepositories:false, ExcludeAttachments:false, ExcludeReleases:false, ExcludeOwnerProjects:false, ExcludeMetadata:false, ExcludeGitData:false, OrgMetadataOnly:false, URL:"", CreatedAt:"", UpdatedAt:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Migration.String = %v, want %v", got, want)
 	}
@@ -883,6 +1020,7 @@ func TestOrganization_String(t *testing.T) {
 		MembersCanCreatePublicRepos:          Bool(false),
 		MembersCanCreatePrivateRepos:         Bool(false),
 		MembersCanCreateInternalRepos:        Bool(false),
+		MembersCanForkPrivateRepos:           Bool(false),
 		MembersAllowedRepositoryCreationType: String(""),
 		URL:                                  String(""),
 		EventsURL:                            String(""),
@@ -892,7 +1030,7 @@ func TestOrganization_String(t *testing.T) {
 		PublicMembersURL:                     String(""),
 		ReposURL:                             String(""),
 	}
-	want := `github.Organization{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", Name:"", Company:"", Blog:"", Location:"", Email:"", TwitterUsername:"", Description:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, BillingEmail:"", Type:"", Plan:github.Plan{}, TwoFactorRequirementEnabled:false, IsVerified:false, HasOrganizationProjects:false, HasRepositoryProjects:false, DefaultRepoPermission:"", DefaultRepoSettings:"", MembersCanCreateRepos:false, MembersCanCreatePublicRepos:false, MembersCanCreatePrivateRepos:false, MembersCanCreateInternalRepos:false, MembersAllowedRepositoryCreationType:"", URL:"", EventsURL:"", HooksURL:"", IssuesURL:"", MembersURL:"", PublicMembersURL:"", ReposURL:""}`
+	want := `github.Organization{Login:"", ID:0, NodeID:"", AvatarURL:"", HTMLURL:"", Name:"", Company:"", Blog:"", Location:"", Email:"", TwitterUsername:"", Description:"", PublicRepos:0, PublicGists:0, Followers:0, Following:0, TotalPrivateRepos:0, OwnedPrivateRepos:0, PrivateGists:0, DiskUsage:0, Collaborators:0, BillingEmail:"", Type:"", Plan:github.Plan{}, TwoFactorRequirementEnabled:false, IsVerified:false, HasOrganizationProjects:false, HasRepositoryProjects:false, DefaultRepoPermission:"", DefaultRepoSettings:"", MembersCanCreateRepos:false, MembersCanCreatePublicRepos:false, MembersCanCreatePrivateRepos:false, MembersCanCreateInternalRepos:false, MembersCanForkPrivateRepos:false, MembersAllowedRepositoryCreationType:"", URL:"", EventsURL:"", HooksURL:"", IssuesURL:"", MembersURL:"", PublicMembersURL:"", ReposURL:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Organization.String = %v, want %v", got, want)
 	}
@@ -902,6 +1040,9 @@ func TestPackage_String(t *testing.T) {
 	v := Package{
 		ID:             Int64(0),
 		Name:           String(""),
+		Namespace:      String(""),
+		Description:    String(""),
+		Ecosystem:      String(""),
 		PackageType:    String(""),
 		HTMLURL:        String(""),
 		CreatedAt:      &Timestamp{},
@@ -910,7 +1051,7 @@ func TestPackage_String(t *testing.T) {
 		PackageVersion: &PackageVersion{},
 		Registry:       &PackageRegistry{},
 	}
-	want := `github.Package{ID:0, Name:"", PackageType:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.User{}, PackageVersion:github.PackageVersion{}, Registry:github.PackageRegistry{}}`
+	want := `github.Package{ID:0, Name:"", Namespace:"", Description:"", Ecosystem:"", PackageType:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.User{}, PackageVersion:github.PackageVersion{}, Registry:github.PackageRegistry{}}`
 	if got := v.String(); got != want {
 		t.Errorf("Package.String = %v, want %v", got, want)
 	}
@@ -1057,6 +1198,37 @@ func TestProject_String(t *testing.T) {
 	}
 }
 
+func TestProjectV2_String(t *testing.T) {
+	v := ProjectV2{
+		ID:               String(""),
+		Number:           Int(0),
+		Title:            String(""),
+		ShortDescription: String(""),
+		URL:              String(""),
+		Closed:           Bool(false),
+		Public:           Bool(false),
+		CreatedAt:        &Timestamp{},
+		UpdatedAt:        &Timestamp{},
+	}
+	want := `github.ProjectV2{ID:"", Number:0, Title:"", ShortDescription:"", URL:"", Closed:false, Public:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	if got := v.String(); got != want {
+		t.Errorf("ProjectV2.String = %v, want %v", got, want)
+	}
+}
+
+func TestProjectV2Item_String(t *testing.T) {
+	v := ProjectV2Item{
+		ID:         String(""),
+		Type:       String(""),
+		IsArchived: Bool(false),
+		Content:    &ProjectV2ItemContent{},
+	}
+	want := `github.ProjectV2Item{ID:"", Type:"", IsArchived:false, Content:github.ProjectV2ItemContent{}}`
+	if got := v.String(); got != want {
+		t.Errorf("ProjectV2Item.String = %v, want %v", got, want)
+	}
+}
+
 func TestPullRequest_String(t *testing.T) {
 	v := PullRequest{
 		ID:                  Int64(0),
@@ -1522,6 +1694,73 @@ func TestRepositoryRelease_String(t *testing.T) {
 	}
 }
 
+func TestRuleSuite_String(t *testing.T) {
+	v := RuleSuite{
+		ID:               Int64(0),
+		ActorID:          Int64(0),
+		ActorName:        String(""),
+		BeforeSHA:        String(""),
+		AfterSHA:         String(""),
+		Ref:              String(""),
+		PushedAt:         &Timestamp{},
+		RepositoryID:     Int64(0),
+		RepositoryName:   String(""),
+		Result:           String(""),
+		EvaluationResult: String(""),
+	}
+	want := `github.RuleSuite{ID:0, ActorID:0, ActorName:"", BeforeSHA:"", AfterSHA:"", Ref:"", PushedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, RepositoryID:0, RepositoryName:"", Result:"", EvaluationResult:""}`
+	if got := v.String(); got != want {
+		t.Errorf("RuleSuite.String = %v, want %v", got, want)
+	}
+}
+
+func TestRuleset_String(t *testing.T) {
+	v := Ruleset{
+		ID:          Int64(0),
+		NodeID:      String(""),
+		Name:        String(""),
+		Target:      String(""),
+		SourceType:  String(""),
+		Source:      String(""),
+		Enforcement: String(""),
+		Conditions:  &RulesetConditions{},
+		CreatedAt:   &Timestamp{},
+		UpdatedAt:   &Timestamp{},
+	}
+	want := `github.Ruleset{ID:0, NodeID:"", Name:"", Target:"", SourceType:"", Source:"", Enforcement:"", Conditions:github.RulesetConditions{}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	if got := v.String(); got != want {
+		t.Errorf("Ruleset.String = %v, want %v", got, want)
+	}
+}
+
+func TestSecretScanningAlert_String(t *testing.T) {
+	v := SecretScanningAlert{
+		Number:                   Int(0),
+		CreatedAt:                &Timestamp{},
+		UpdatedAt:                &Timestamp{},
+		URL:                      String(""),
+		HTMLURL:                  String(""),
+		LocationsURL:             String(""),
+		State:                    String(""),
+		SecretType:               String(""),
+		SecretTypeDisplayName:    String(""),
+		Secret:                   String(""),
+		Repository:               &Repository{},
+		Resolution:               String(""),
+		ResolvedBy:               &User{},
+		ResolvedAt:               &Timestamp{},
+		ResolutionComment:        String(""),
+		Validity:                 String(""),
+		PushProtectionBypassed:   Bool(false),
+		PushProtectionBypassedBy: &User{},
+		PushProtectionBypassedAt: &Timestamp{},
+	}
+	want := `github.SecretScanningAlert{Number:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", LocationsURL:"", State:"", SecretType:"", SecretTypeDisplayName:"", Secret:"", Repository:github.Repository{}, Resolution:"", ResolvedBy:github.User{}, ResolvedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, ResolutionComment:"", Validity:"", PushProtectionBypassed:false, PushProtectionBypassedBy:github.User{}, PushProtectionBypassedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	if got := v.String(); got != want {
+		t.Errorf("SecretScanningAlert.String = %v, want %v", got, want)
+	}
+}
+
 func TestSourceImportAuthor_String(t *testing.T) {
 	v := SourceImportAuthor{
 		ID:         Int64(0),
@@ -1648,6 +1887,26 @@ func TestTreeEntry_String(t *testing.T) {
 	}
 }
 
+func TestUsageItem_String(t *testing.T) {
+	v := UsageItem{
+		Date:             String(""),
+		Product:          String(""),
+		SKU:              String(""),
+		Quantity:         Float64(0.0),
+		UnitType:         String(""),
+		PricePerUnit:     Float64(0.0),
+		GrossAmount:      Float64(0.0),
+		DiscountAmount:   Float64(0.0),
+		NetAmount:        Float64(0.0),
+		OrganizationName: String(""),
+		RepositoryName:   String(""),
+	}
+	want := `github.UsageItem{Date:"", Product:"", SKU:"", Quantity:0, UnitType:"", PricePerUnit:0, GrossAmount:0, DiscountAmount:0, NetAmount:0, OrganizationName:"", RepositoryName:""}`
+	if got := v.String(); got != want {
+		t.Errorf("UsageItem.String = %v, want %v", got, want)
+	}
+}
+
 func TestUser_String(t *testing.T) {
 	v := User{
 		Login:                   String(""),
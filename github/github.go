@@ -32,10 +32,13 @@ const (
 	uploadBaseURL  = "https://uploads.github.com/"
 	userAgent      = "go-github"
 
-	headerRateLimit     = "X-RateLimit-Limit"
-	headerRateRemaining = "X-RateLimit-Remaining"
-	headerRateReset     = "X-RateLimit-Reset"
-	headerOTP           = "X-GitHub-OTP"
+	headerRateLimit         = "X-RateLimit-Limit"
+	headerRateRemaining     = "X-RateLimit-Remaining"
+	headerRateReset         = "X-RateLimit-Reset"
+	headerOTP               = "X-GitHub-OTP"
+	headerOAuthScopes       = "X-OAuth-Scopes"
+	headerFromCache         = "X-From-Cache"
+	headerEnterpriseVersion = "X-GitHub-Enterprise-Version"
 
 	mediaTypeV3                = "application/vnd.github.v3+json"
 	defaultMediaType           = "application/octet-stream"
@@ -148,9 +151,15 @@ type Client struct {
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
+	requestOptions []RequestOption // Applied to every request; see WithRequestOptions.
+	maxPages       int             // Maximum number of pages fetched by the ListXxxAll helpers; see WithMaxPages.
+
 	rateMu     sync.Mutex
 	rateLimits [categories]Rate // Rate limits for the client as determined by the most recent API calls.
 
+	installationMu     sync.Mutex
+	installationTokens map[int64]TokenSource // Installation tokens minted by Apps.NewInstallationClient, by installation ID.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
@@ -159,6 +168,7 @@ type Client struct {
 	Admin          *AdminService
 	Apps           *AppsService
 	Authorizations *AuthorizationsService
+	Batch          *BatchService
 	Checks         *ChecksService
 	CodeScanning   *CodeScanningService
 	Enterprise     *EnterpriseService
@@ -173,10 +183,12 @@ type Client struct {
 	Migrations     *MigrationService
 	Organizations  *OrganizationsService
 	Projects       *ProjectsService
+	ProjectsV2     *ProjectsV2Service
 	PullRequests   *PullRequestsService
 	Reactions      *ReactionsService
 	Repositories   *RepositoriesService
 	Search         *SearchService
+	SecretScanning *SecretScanningService
 	Teams          *TeamsService
 	Users          *UsersService
 }
@@ -203,6 +215,28 @@ type ListCursorOptions struct {
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty"`
+
+	// For paginated result sets, the cursor after which to return results.
+	// Set it to Response.After to fetch the next page.
+	After string `url:"after,omitempty"`
+
+	// For paginated result sets, the cursor before which to return results.
+	// Set it to Response.Before to fetch the previous page.
+	Before string `url:"before,omitempty"`
+}
+
+// ListSinceOptions specifies the optional parameters to methods that support
+// "since" pagination, where each page is requested with the ID of the last
+// item seen instead of a page number. It is used by the ListAllFunc methods
+// of the services listing every user, organization, or repository.
+type ListSinceOptions struct {
+	// Since is the ID of the last item seen. Only items with a greater ID
+	// are returned.
+	Since int64 `url:"since,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	// Not every endpoint supports it.
+	PerPage int `url:"per_page,omitempty"`
 }
 
 // UploadOptions specifies the parameters to methods that support uploads.
@@ -262,12 +296,20 @@ func NewClient(httpClient *http.Client) *Client {
 	uploadURL, _ := url.Parse(uploadBaseURL)
 
 	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, UploadURL: uploadURL}
+	c.initialize()
+	return c
+}
+
+// initialize sets up the services of c. It must be called once the
+// Client has been allocated, before any of its services are used.
+func (c *Client) initialize() {
 	c.common.client = c
 	c.Actions = (*ActionsService)(&c.common)
 	c.Activity = (*ActivityService)(&c.common)
 	c.Admin = (*AdminService)(&c.common)
 	c.Apps = (*AppsService)(&c.common)
 	c.Authorizations = (*AuthorizationsService)(&c.common)
+	c.Batch = (*BatchService)(&c.common)
 	c.Checks = (*ChecksService)(&c.common)
 	c.CodeScanning = (*CodeScanningService)(&c.common)
 	c.Enterprise = (*EnterpriseService)(&c.common)
@@ -282,13 +324,96 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Migrations = (*MigrationService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
+	c.ProjectsV2 = (*ProjectsV2Service)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
 	c.Reactions = (*ReactionsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
 	c.Search = (*SearchService)(&c.common)
+	c.SecretScanning = (*SecretScanningService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
-	return c
+}
+
+// Clone returns a copy of c that can be reconfigured independently of c,
+// for example to use a different UserAgent or BaseURL in one goroutine
+// without affecting others sharing c.
+//
+// The copy has its own http.Client, but that http.Client uses the same
+// http.RoundTripper as c, so both clients share the underlying connection
+// pool and any authentication performed by that transport. The copy starts
+// with the rate limits last observed by c, but tracks them separately from
+// then on.
+func (c *Client) Clone() *Client {
+	c.clientMu.Lock()
+	httpClient := *c.client
+	c.clientMu.Unlock()
+
+	c2 := &Client{
+		client:         &httpClient,
+		UserAgent:      c.UserAgent,
+		requestOptions: c.requestOptions,
+		maxPages:       c.maxPages,
+	}
+	if c.BaseURL != nil {
+		u := *c.BaseURL
+		c2.BaseURL = &u
+	}
+	if c.UploadURL != nil {
+		u := *c.UploadURL
+		c2.UploadURL = &u
+	}
+
+	c.rateMu.Lock()
+	c2.rateLimits = c.rateLimits
+	c.rateMu.Unlock()
+
+	c2.initialize()
+	c2.Marketplace.Stubbed = c.Marketplace.Stubbed
+	return c2
+}
+
+// WithAuthToken returns a copy of c (see Clone) whose requests are
+// authenticated with the given OAuth or personal access token, sent in the
+// Authorization header. The copy reuses the connection pool of c.
+//
+// WithAuthToken is meant for clients whose transport does not already
+// authenticate requests, such as one created with NewClient(nil). If the
+// transport of c sets the Authorization header itself, as an oauth2.Transport
+// does, that header takes precedence.
+func (c *Client) WithAuthToken(token string) *Client {
+	c2 := c.Clone()
+	c2.client.Transport = &tokenAuthTransport{
+		token:     token,
+		Transport: c2.client.Transport,
+	}
+	return c2
+}
+
+// tokenAuthTransport is an http.RoundTripper that authenticates all requests
+// with a token. It is used by Client.WithAuthToken.
+type tokenAuthTransport struct {
+	token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *tokenAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// To set extra headers, we must make a copy of the Request so
+	// that we don't modify the Request we were given. This is required by the
+	// specification of http.RoundTripper.
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "token "+t.token)
+	return t.transport().RoundTrip(req2)
+}
+
+func (t *tokenAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
 }
 
 // NewEnterpriseClient returns a new GitHub API client with provided
@@ -337,12 +462,55 @@ func NewEnterpriseClient(baseURL, uploadURL string, httpClient *http.Client) (*C
 	return c, nil
 }
 
+// RequestOption customizes an API request, such as by setting a header. See
+// Client.WithRequestOptions.
+type RequestOption func(req *http.Request)
+
+// WithTimeZone returns a RequestOption that sets the Time-Zone header to tz,
+// a name from the IANA time zone database such as "Europe/Amsterdam".
+//
+// GitHub uses the header to interpret timestamps given without a time zone,
+// and to choose the time zone of timestamps it generates, in requests that
+// create commits. Without it, GitHub falls back to the last known time zone
+// of the user, then to UTC. The methods it applies to are:
+//
+//   - RepositoriesService.CreateFile, UpdateFile and DeleteFile, for the
+//     author and committer dates of the commit they create.
+//   - GitService.CreateCommit, for author and committer dates given without
+//     a time zone or left out.
+//
+// GitHub applies the header only to data passed to the API, not to the data
+// it returns. In particular, it does not move the day or hour boundaries of
+// the repository statistics of RepositoriesService.ListCommitActivity,
+// ListParticipation, GetWeeklyCommitCount and ListPunchCard.
+//
+// Service methods do not take request options; apply WithTimeZone to a client
+// with Client.WithRequestOptions.
+//
+// GitHub API docs: https://docs.github.com/en/rest/overview/resources-in-the-rest-api#timezones
+func WithTimeZone(tz string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Time-Zone", tz)
+	}
+}
+
+// WithRequestOptions returns a copy of c (see Clone) that applies opts to
+// every request it creates, after any request options c already applies.
+//
+//	client = client.WithRequestOptions(github.WithTimeZone("Asia/Tokyo"))
+func (c *Client) WithRequestOptions(opts ...RequestOption) *Client {
+	c2 := c.Clone()
+	c2.requestOptions = append(append([]RequestOption(nil), c.requestOptions...), opts...)
+	return c2
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body.
-func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+// request body. The request options of the client, followed by opts, are
+// applied to the request.
+func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
@@ -374,9 +542,19 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
+	c.applyRequestOptions(req, opts)
 	return req, nil
 }
 
+func (c *Client) applyRequestOptions(req *http.Request, opts []RequestOption) {
+	for _, opt := range c.requestOptions {
+		opt(req)
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+}
+
 // NewUploadRequest creates an upload request. A relative URL can be provided in
 // urlStr, in which case it is resolved relative to the UploadURL of the Client.
 // Relative URLs should always be specified without a preceding slash.
@@ -401,6 +579,7 @@ func (c *Client) NewUploadRequest(urlStr string, reader io.Reader, size int64, m
 	req.Header.Set("Content-Type", mediaType)
 	req.Header.Set("Accept", mediaTypeV3)
 	req.Header.Set("User-Agent", c.UserAgent)
+	c.applyRequestOptions(req, nil)
 	return req, nil
 }
 
@@ -435,9 +614,27 @@ type Response struct {
 	// calling the endpoint again.
 	NextPageToken string
 
+	// For APIs that support before/after cursor pagination (such as
+	// OrganizationsService.GetAuditLog), the following fields will be
+	// populated with the cursors of the next and previous pages.
+	//
+	// To use them, set ListCursorOptions.After or ListCursorOptions.Before
+	// to these values before calling the endpoint again. They are plain
+	// strings, so they can be persisted to resume iteration later.
+	After  string
+	Before string
+
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
 	Rate Rate
+
+	// FromCache is true if the response was served by a caching transport,
+	// such as https://github.com/gregjones/httpcache, rather than fetched
+	// from GitHub, including when the cached response was revalidated with a
+	// 304 Not Modified. Such responses are recognized by their X-From-Cache
+	// header. Their Rate may be stale, so it is not used to update the rate
+	// limits tracked by the Client.
+	FromCache bool
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -446,6 +643,7 @@ func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
 	response.Rate = parseRate(r)
+	response.FromCache = r.Header.Get(headerFromCache) == "1"
 	return response
 }
 
@@ -471,19 +669,26 @@ func (r *Response) populatePageValues() {
 			if err != nil {
 				continue
 			}
-			page := url.Query().Get("page")
-			if page == "" {
+			q := url.Query()
+			page := q.Get("page")
+			after := q.Get("after")
+			before := q.Get("before")
+			if page == "" && after == "" && before == "" {
 				continue
 			}
 
 			for _, segment := range segments[1:] {
 				switch strings.TrimSpace(segment) {
 				case `rel="next"`:
-					if r.NextPage, err = strconv.Atoi(page); err != nil {
-						r.NextPageToken = page
+					if page != "" {
+						if r.NextPage, err = strconv.Atoi(page); err != nil {
+							r.NextPageToken = page
+						}
 					}
+					r.After = after
 				case `rel="prev"`:
 					r.PrevPage, _ = strconv.Atoi(page)
+					r.Before = before
 				case `rel="first"`:
 					r.FirstPage, _ = strconv.Atoi(page)
 				case `rel="last"`:
@@ -512,22 +717,71 @@ func parseRate(r *http.Response) Rate {
 	return rate
 }
 
-// Do sends an API request and returns the API response. The API response is
-// JSON decoded and stored in the value pointed to by v, or returned as an
-// error if an API error has occurred. If v implements the io.Writer
-// interface, the raw response body will be written to v, without attempting to
-// first decode it. If rate limit is exceeded and reset time is in the future,
-// Do returns *RateLimitError immediately without making a network API call.
+// secondaryRateLimitDelay is how long to wait after a 429 Too Many Requests
+// response that does not say when to retry.
+const secondaryRateLimitDelay = time.Minute
+
+// SuggestedRetryDelay reports whether the request that got r was rejected by
+// a rate limit, and so is worth retrying later, and how long to wait before
+// doing so.
 //
-// The provided ctx must be non-nil, if it is nil an error is returned. If it is canceled or times out,
-// ctx.Err() will be returned.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+// Both the primary rate limit, whose exhaustion is signaled by a 403 or 429
+// response with no remaining requests, and secondary rate limits, signaled
+// by a Retry-After header, are taken into account: if both apply, the longer
+// of the two delays is returned. A 429 response that says neither when the
+// limit resets nor when to retry suggests waiting one minute, as GitHub
+// recommends. Other 403 responses, which may be permission errors, and
+// successful responses return false.
+func (r *Response) SuggestedRetryDelay() (time.Duration, bool) {
+	if r == nil || r.Response == nil || r.StatusCode < 400 {
+		return 0, false
+	}
+
+	var delay time.Duration
+	retry := false
+	if v := r.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+			delay, retry = time.Duration(secs)*time.Second, true
+		} else if t, err := http.ParseTime(v); err == nil {
+			delay, retry = time.Until(t), true
+		}
+	}
+
+	limited := r.StatusCode == http.StatusForbidden || r.StatusCode == http.StatusTooManyRequests
+	if limited && r.Header.Get(headerRateRemaining) == "0" {
+		if reset := parseRate(r.Response).Reset; !reset.IsZero() {
+			if d := time.Until(reset.Time); d > delay {
+				delay = d
+			}
+			retry = true
+		}
+	}
+
+	if !retry && r.StatusCode == http.StatusTooManyRequests {
+		delay, retry = secondaryRateLimitDelay, true
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, retry
+}
+
+// BareDo sends an API request and lets you handle the API response. If an
+// error or API error occurs, the error will contain more information, and
+// the response body is closed. Otherwise the caller is responsible for
+// reading and closing the body of the returned Response, which makes BareDo
+// suited to streaming large responses. Rate limits are checked and tracked
+// as for Do.
+//
+// The provided ctx must be non-nil, if it is nil an error is returned. If it
+// is canceled or times out, ctx.Err() will be returned.
+func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	if ctx == nil {
 		return nil, errors.New("context must be non-nil")
 	}
 	req = withContext(ctx, req)
 
-	rateLimitCategory := category(req.URL.Path)
+	rateLimitCategory := category(req)
 
 	// If we've hit rate limit, don't make further requests before Reset time.
 	if err := c.checkRateLimitBeforeDo(req, rateLimitCategory); err != nil {
@@ -558,16 +812,17 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		return nil, err
 	}
 
-	defer resp.Body.Close()
-
 	response := newResponse(resp)
 
-	c.rateMu.Lock()
-	c.rateLimits[rateLimitCategory] = response.Rate
-	c.rateMu.Unlock()
+	if !response.FromCache {
+		c.rateMu.Lock()
+		c.rateLimits[rateLimitCategory] = response.Rate
+		c.rateMu.Unlock()
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
+		defer resp.Body.Close()
 		// Special case for AcceptedErrors. If an AcceptedError
 		// has been encountered, the response's payload will be
 		// added to the AcceptedError and returned.
@@ -587,9 +842,30 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		return response, err
 	}
 
+	return response, nil
+}
+
+// Do sends an API request and returns the API response. The API response is
+// JSON decoded and stored in the value pointed to by v, or returned as an
+// error if an API error has occurred. If v implements the io.Writer
+// interface, the raw response body will be written to v, without attempting to
+// first decode it. If rate limit is exceeded and reset time is in the future,
+// Do returns *RateLimitError immediately without making a network API call.
+//
+// The provided ctx must be non-nil, if it is nil an error is returned. If it is canceled or times out,
+// ctx.Err() will be returned.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	resp, err := c.BareDo(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
 			io.Copy(w, resp.Body)
+		} else if fn, ok := v.(jsonArrayStream); ok {
+			err = decodeJSONArray(resp.Body, fn)
 		} else {
 			decErr := json.NewDecoder(resp.Body).Decode(v)
 			if decErr == io.EOF {
@@ -601,7 +877,37 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		}
 	}
 
-	return response, err
+	return resp, err
+}
+
+// jsonArrayStream can be passed to Client.Do in place of a pointer to a slice
+// to decode a JSON array response one element at a time, without buffering
+// the whole array. It is called with a decoder positioned before each element
+// and must decode exactly one value from it.
+type jsonArrayStream func(dec *json.Decoder) error
+
+// decodeJSONArray decodes the JSON array read from r, calling fn for each of
+// its elements. It stops at the first error returned by fn.
+func decodeJSONArray(r io.Reader, fn jsonArrayStream) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return nil // Empty response body.
+	}
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected a JSON array, got %v", tok)
+	}
+
+	for dec.More() {
+		if err := fn(dec); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token() // Closing bracket.
+	return err
 }
 
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
@@ -654,9 +960,76 @@ type ErrorResponse struct {
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v %+v",
+	msg := fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
-		r.Response.StatusCode, r.Message, r.Errors)
+		r.Response.StatusCode, r.Message)
+	if len(r.Errors) == 0 {
+		return msg
+	}
+
+	details := make([]string, len(r.Errors))
+	for i, e := range r.Errors {
+		switch {
+		case e.Field == "" && e.Code == "":
+			details[i] = e.Message
+		case e.Message == "":
+			details[i] = fmt.Sprintf("%v: %v", e.Field, e.Code)
+		default:
+			details[i] = fmt.Sprintf("%v: %v (%v)", e.Field, e.Code, e.Message)
+		}
+	}
+	return fmt.Sprintf("%v [%v]", msg, strings.Join(details, "; "))
+}
+
+// ValidationErrors returns the per-field validation errors reported by
+// GitHub when a request is rejected with 422 Unprocessable Entity.
+// It returns nil for any other kind of error response.
+func (r *ErrorResponse) ValidationErrors() []ValidationError {
+	if r.Response == nil || r.Response.StatusCode != http.StatusUnprocessableEntity {
+		return nil
+	}
+
+	var errs []ValidationError
+	for _, e := range r.Errors {
+		errs = append(errs, ValidationError{
+			Resource: e.Resource,
+			Field:    e.Field,
+			Code:     e.Code,
+			Message:  e.Message,
+		})
+	}
+	return errs
+}
+
+// ValidationError describes a single field of a request that failed
+// validation. See Error for the possible values of Code.
+type ValidationError struct {
+	Resource string // resource on which the error occurred
+	Field    string // field on which the error occurred
+	Code     string // validation error code
+	Message  string // message describing the error, if any
+}
+
+// Suggestion returns a short, human-readable hint describing how the
+// validation error can usually be resolved. It returns the Message of
+// errors with the "custom" code, and an empty string for unknown codes.
+func (e ValidationError) Suggestion() string {
+	switch e.Code {
+	case "missing":
+		return fmt.Sprintf("the %v referenced by %v does not exist", e.Resource, e.Field)
+	case "missing_field":
+		return fmt.Sprintf("%v is required", e.Field)
+	case "invalid":
+		return fmt.Sprintf("the value of %v is not formatted correctly", e.Field)
+	case "already_exists":
+		return fmt.Sprintf("another %v already has this %v; choose a different value", e.Resource, e.Field)
+	case "unprocessable":
+		return fmt.Sprintf("the value of %v could not be processed", e.Field)
+	case "custom":
+		return e.Message
+	default:
+		return ""
+	}
 }
 
 // TwoFactorAuthError occurs when using HTTP Basic Authentication for a user
@@ -739,6 +1112,8 @@ These are the possible validation error codes:
         the formatting of a field is invalid
     already_exists:
         another resource has the same valid as this field
+    unprocessable:
+        the parameters provided were invalid
     custom:
         some resources return this (e.g. github.User.CreateKey()), additional
         information is set in the Message field of the Error
@@ -872,6 +1247,11 @@ type RateLimits struct {
 	//
 	// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#rate-limit
 	Search *Rate `json:"search"`
+
+	// The rate limit for GraphQL API requests.
+	//
+	// GitHub API docs: https://docs.github.com/en/graphql/overview/resource-limitations#rate-limit
+	GraphQL *Rate `json:"graphql"`
 }
 
 func (r RateLimits) String() string {
@@ -883,20 +1263,78 @@ type rateLimitCategory uint8
 const (
 	coreCategory rateLimitCategory = iota
 	searchCategory
+	graphqlCategory
 
 	categories // An array of this length will be able to contain all rate limit categories.
 )
 
-// category returns the rate limit category of the endpoint, determined by Request.URL.Path.
-func category(path string) rateLimitCategory {
-	switch {
+// category returns the rate limit category the client tracks req in, based
+// on RateLimitCategory. Buckets the client does not track separately are
+// tracked as core, except code_search, which is tracked as search.
+func category(req *http.Request) rateLimitCategory {
+	switch RateLimitCategory(req) {
 	default:
 		return coreCategory
-	case strings.HasPrefix(path, "/search/"):
+	case "search", "code_search":
 		return searchCategory
+	case "graphql":
+		return graphqlCategory
 	}
 }
 
+// RateLimitCategory returns the name of the rate limit bucket that req counts
+// against, as reported by GitHub in the X-RateLimit-Resource response header.
+// Possible values are: core, search, code_search, graphql,
+// integration_manifest, source_import, code_scanning_upload,
+// actions_runner_registration, scim and dependency_snapshots.
+//
+// The category is determined by the request method and the path of req.URL,
+// with any GitHub Enterprise Server "/api/v3" prefix ignored.
+//
+// GitHub API docs: https://docs.github.com/en/rest/rate-limit#get-rate-limit-status-for-the-authenticated-user
+func RateLimitCategory(req *http.Request) string {
+	path := req.URL.Path
+	if path == "/graphql" || path == "/api/graphql" {
+		return "graphql"
+	}
+	path = strings.TrimPrefix(path, "/api/v3")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	post := req.Method == "POST"
+
+	switch parts[0] {
+	case "search":
+		if len(parts) > 1 && parts[1] == "code" {
+			return "code_search"
+		}
+		return "search"
+	case "scim":
+		return "scim"
+	case "app-manifests":
+		if post && len(parts) == 3 && parts[2] == "conversions" {
+			return "integration_manifest"
+		}
+	case "orgs":
+		if post && len(parts) == 5 && parts[2] == "actions" && parts[3] == "runners" && parts[4] == "registration-token" {
+			return "actions_runner_registration"
+		}
+	case "repos":
+		if len(parts) < 4 {
+			break
+		}
+		switch rest := strings.Join(parts[3:], "/"); {
+		case parts[3] == "import":
+			return "source_import"
+		case post && rest == "code-scanning/sarifs":
+			return "code_scanning_upload"
+		case post && rest == "dependency-graph/snapshots":
+			return "dependency_snapshots"
+		case post && rest == "actions/runners/registration-token":
+			return "actions_runner_registration"
+		}
+	}
+	return "core"
+}
+
 // RateLimits returns the rate limits for the current client.
 func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error) {
 	req, err := c.NewRequest("GET", "rate_limit", nil)
@@ -920,12 +1358,39 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 		if response.Resources.Search != nil {
 			c.rateLimits[searchCategory] = *response.Resources.Search
 		}
+		if response.Resources.GraphQL != nil {
+			c.rateLimits[graphqlCategory] = *response.Resources.GraphQL
+		}
 		c.rateMu.Unlock()
 	}
 
 	return response.Resources, resp, nil
 }
 
+// LastRateLimits returns the rate limits of the client as reported by the
+// response headers of the most recent request in each category, without
+// making any request. The Core, Search and GraphQL fields are nil for the
+// categories the client has not made any request in yet, or whose most
+// recent response carried no rate limit headers. It is safe to call
+// concurrently with requests.
+func (c *Client) LastRateLimits() *RateLimits {
+	c.rateMu.Lock()
+	rates := c.rateLimits
+	c.rateMu.Unlock()
+
+	known := func(r Rate) *Rate {
+		if r.Limit == 0 {
+			return nil
+		}
+		return &r
+	}
+	return &RateLimits{
+		Core:    known(rates[coreCategory]),
+		Search:  known(rates[searchCategory]),
+		GraphQL: known(rates[graphqlCategory]),
+	}
+}
+
 func setCredentialsAsHeaders(req *http.Request, id, secret string) *http.Request {
 	// To set extra headers, we must make a copy of the Request so
 	// that we don't modify the Request we were given. This is required by the
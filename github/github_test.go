@@ -6,6 +6,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -182,6 +183,69 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestClient_Clone(t *testing.T) {
+	c := NewClient(nil)
+	c.Marketplace.Stubbed = true
+	c.rateLimits[coreCategory] = Rate{Limit: 5000, Remaining: 42}
+
+	c2 := c.Clone()
+	if c2.client == c.client {
+		t.Error("Clone returned a client sharing the same http.Client")
+	}
+	if c2.client.Transport != c.client.Transport {
+		t.Error("Clone returned a client not sharing the same http.RoundTripper")
+	}
+	if got, want := c2.rateLimits[coreCategory], c.rateLimits[coreCategory]; got != want {
+		t.Errorf("Clone rate limits are %+v, want %+v", got, want)
+	}
+	if !c2.Marketplace.Stubbed {
+		t.Error("Clone did not preserve Marketplace.Stubbed")
+	}
+	if c2.Repositories.client != c2 {
+		t.Error("Clone services do not use the cloned client")
+	}
+
+	c2.UserAgent = "other"
+	c2.BaseURL.Path = "/other/"
+	if c.UserAgent != userAgent {
+		t.Errorf("modifying the clone changed UserAgent to %q", c.UserAgent)
+	}
+	if got, want := c.BaseURL.String(), defaultBaseURL; got != want {
+		t.Errorf("modifying the clone changed BaseURL to %v, want %v", got, want)
+	}
+}
+
+func TestClient_WithAuthToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Header.Get("Authorization"))
+	})
+
+	tokenClient := client.WithAuthToken("t")
+	if tokenClient == client {
+		t.Fatal("WithAuthToken returned the original client")
+	}
+
+	for _, tc := range []struct {
+		client *Client
+		want   string
+	}{
+		{client, ""},
+		{tokenClient, "token t"},
+	} {
+		req, _ := tc.client.NewRequest("GET", ".", nil)
+		var buf bytes.Buffer
+		if _, err := tc.client.Do(context.Background(), req, &buf); err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+		if got := buf.String(); got != tc.want {
+			t.Errorf("Authorization header is %q, want %q", got, tc.want)
+		}
+	}
+}
+
 func TestNewEnterpriseClient(t *testing.T) {
 	baseURL := "https://custom-url/api/v3/"
 	uploadURL := "https://custom-upload-url/api/uploads/"
@@ -372,6 +436,72 @@ func TestClient_rateLimits(t *testing.T) {
 	}
 }
 
+func TestDecodeJSONArray(t *testing.T) {
+	tests := []struct {
+		body    string
+		want    []int
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"[]", nil, false},
+		{"[1, 2, 3]", []int{1, 2, 3}, false},
+		{`{"a":1}`, nil, true},
+		{"[1, 2", []int{1, 2}, true},
+	}
+
+	for _, tt := range tests {
+		var got []int
+		err := decodeJSONArray(strings.NewReader(tt.body), func(dec *json.Decoder) error {
+			var v int
+			if err := dec.Decode(&v); err != nil {
+				return err
+			}
+			got = append(got, v)
+			return nil
+		})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("decodeJSONArray(%q) returned error %v, want error: %v", tt.body, err, tt.wantErr)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("decodeJSONArray(%q) decoded %v, want %v", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimitCategory(t *testing.T) {
+	tests := []struct {
+		method string
+		url    string
+		want   string
+	}{
+		{"GET", "https://api.github.com/repos/o/r", "core"},
+		{"GET", "https://api.github.com/search/repositories?q=go", "search"},
+		{"GET", "https://api.github.com/search/code?q=go", "code_search"},
+		{"POST", "https://api.github.com/graphql", "graphql"},
+		{"POST", "https://ghe.example.com/api/graphql", "graphql"},
+		{"POST", "https://api.github.com/app-manifests/c/conversions", "integration_manifest"},
+		{"GET", "https://api.github.com/repos/o/r/import/authors", "source_import"},
+		{"POST", "https://api.github.com/repos/o/r/code-scanning/sarifs", "code_scanning_upload"},
+		{"GET", "https://api.github.com/repos/o/r/code-scanning/sarifs/1", "core"},
+		{"POST", "https://api.github.com/orgs/o/actions/runners/registration-token", "actions_runner_registration"},
+		{"POST", "https://api.github.com/repos/o/r/actions/runners/registration-token", "actions_runner_registration"},
+		{"GET", "https://api.github.com/scim/v2/organizations/o/Users", "scim"},
+		{"POST", "https://api.github.com/repos/o/r/dependency-graph/snapshots", "dependency_snapshots"},
+		{"GET", "https://ghe.example.com/api/v3/search/issues?q=go", "search"},
+		{"GET", "https://ghe.example.com/api/v3/repos/o/r/import", "source_import"},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.url, nil)
+		if err != nil {
+			t.Fatalf("http.NewRequest returned error: %v", err)
+		}
+		if got := RateLimitCategory(req); got != tt.want {
+			t.Errorf("RateLimitCategory(%v %v) = %q, want %q", tt.method, tt.url, got, tt.want)
+		}
+	}
+}
+
 func TestRateLimits_String(t *testing.T) {
 	v := RateLimits{
 		Core:   &Rate{},
@@ -407,6 +537,47 @@ func TestNewRequest(t *testing.T) {
 	}
 }
 
+func TestNewRequest_requestOptions(t *testing.T) {
+	c := NewClient(nil)
+
+	req, _ := c.NewRequest("GET", ".", nil, WithTimeZone("Europe/Amsterdam"))
+	if got, want := req.Header.Get("Time-Zone"), "Europe/Amsterdam"; got != want {
+		t.Errorf("NewRequest() Time-Zone is %v, want %v", got, want)
+	}
+
+	req, _ = c.NewRequest("GET", ".", nil)
+	if got := req.Header.Get("Time-Zone"); got != "" {
+		t.Errorf("NewRequest() without options set Time-Zone to %v", got)
+	}
+}
+
+func TestClient_WithRequestOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Time-Zone", "Asia/Tokyo")
+		fmt.Fprint(w, `{"commit":{"sha":"s"}}`)
+	})
+
+	tzClient := client.WithRequestOptions(WithTimeZone("Asia/Tokyo"))
+	opts := &RepositoryContentFileOptions{Message: String("m"), Content: []byte("c")}
+	if _, _, err := tzClient.Repositories.CreateFile(context.Background(), "o", "r", "p", opts); err != nil {
+		t.Errorf("Repositories.CreateFile returned error: %v", err)
+	}
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	if got := req.Header.Get("Time-Zone"); got != "" {
+		t.Errorf("WithRequestOptions modified the original client, which set Time-Zone to %v", got)
+	}
+
+	// Options of a derived client apply in order, after inherited ones.
+	req, _ = tzClient.WithRequestOptions(WithTimeZone("UTC")).NewRequest("GET", ".", nil)
+	if got, want := req.Header.Get("Time-Zone"), "UTC"; got != want {
+		t.Errorf("NewRequest() Time-Zone is %v, want %v", got, want)
+	}
+}
+
 func TestNewRequest_invalidJSON(t *testing.T) {
 	c := NewClient(nil)
 
@@ -561,6 +732,30 @@ func TestResponse_cursorPagination(t *testing.T) {
 	}
 }
 
+func TestResponse_beforeAfterPagination(t *testing.T) {
+	r := http.Response{
+		Header: http.Header{
+			"Link": {`<https://api.github.com/orgs/o/audit-log?per_page=2&after=MS42NjQ%3D>; rel="next", ` +
+				`<https://api.github.com/orgs/o/audit-log?per_page=2&before=MS42Mjg%3D>; rel="prev"`,
+			},
+		},
+	}
+
+	response := newResponse(&r)
+	if got, want := response.After, "MS42NjQ="; want != got {
+		t.Errorf("response.After: %v, want %v", got, want)
+	}
+	if got, want := response.Before, "MS42Mjg="; want != got {
+		t.Errorf("response.Before: %v, want %v", got, want)
+	}
+	if got, want := response.NextPage, 0; want != got {
+		t.Errorf("response.NextPage: %v, want %v", got, want)
+	}
+	if got, want := response.NextPageToken, ""; want != got {
+		t.Errorf("response.NextPageToken: %v, want %v", got, want)
+	}
+}
+
 func TestResponse_populatePageValues_invalid(t *testing.T) {
 	r := http.Response{
 		Header: http.Header{
@@ -725,6 +920,85 @@ func TestDo_rateLimit(t *testing.T) {
 	}
 }
 
+// etagCacheTransport is a minimal caching transport that revalidates the
+// last response it got with If-None-Match, like httpcache does.
+type etagCacheTransport struct {
+	cached *http.Response
+	body   []byte
+}
+
+func (t *etagCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cached != nil {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", t.cached.Header.Get("ETag"))
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && t.cached != nil {
+		resp.Body.Close()
+		cached := *t.cached
+		cached.Header = t.cached.Header.Clone()
+		for k, v := range resp.Header {
+			cached.Header[k] = v
+		}
+		cached.Header.Set(headerFromCache, "1")
+		cached.Body = ioutil.NopCloser(bytes.NewReader(t.body))
+		return &cached, nil
+	}
+
+	t.body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	t.cached = resp
+	resp.Body = ioutil.NopCloser(bytes.NewReader(t.body))
+	return resp, nil
+}
+
+func TestDo_fromCache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.client.Transport = &etagCacheTransport{}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		if r.Header.Get("If-None-Match") == `"e"` {
+			w.Header().Set(headerRateRemaining, "0")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set("ETag", `"e"`)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	type foo struct {
+		A string
+	}
+
+	for i, wantFromCache := range []bool{false, true} {
+		req, _ := client.NewRequest("GET", ".", nil)
+		body := new(foo)
+		resp, err := client.Do(context.Background(), req, body)
+		if err != nil {
+			t.Fatalf("#%d: Do returned unexpected error: %v", i, err)
+		}
+		if resp.FromCache != wantFromCache {
+			t.Errorf("#%d: Response.FromCache = %v, want %v", i, resp.FromCache, wantFromCache)
+		}
+		if want := (&foo{"a"}); !reflect.DeepEqual(body, want) {
+			t.Errorf("#%d: Response body = %v, want %v", i, body, want)
+		}
+	}
+
+	if got, want := client.LastRateLimits().GetCore().Remaining, 59; got != want {
+		t.Errorf("Client rate remaining = %v, want %v from the uncached response", got, want)
+	}
+}
+
 // ensure rate limit is still parsed, even for error responses
 func TestDo_rateLimit_errorResponse(t *testing.T) {
 	client, mux, _, teardown := setup()
@@ -1161,6 +1435,58 @@ func TestErrorResponse_Error(t *testing.T) {
 	}
 }
 
+func TestErrorResponse_Error_validationFailed(t *testing.T) {
+	u, _ := url.Parse("https://api.github.com/repos/o/r/issues")
+	res := &http.Response{
+		Request:    &http.Request{Method: "POST", URL: u},
+		StatusCode: http.StatusUnprocessableEntity,
+		Body: ioutil.NopCloser(strings.NewReader(`{
+			"message": "Validation Failed",
+			"errors": [
+				{"resource": "Issue", "field": "title", "code": "missing_field"},
+				{"resource": "Issue", "field": "milestone", "code": "invalid", "message": "milestone 9 is closed"},
+				{"resource": "Label", "field": "name", "code": "already_exists"}
+			],
+			"documentation_url": "https://docs.github.com/rest/reference/issues#create-an-issue"
+		}`)),
+	}
+	err := CheckResponse(res).(*ErrorResponse)
+
+	want := "POST https://api.github.com/repos/o/r/issues: 422 Validation Failed " +
+		"[title: missing_field; milestone: invalid (milestone 9 is closed); name: already_exists]"
+	if got := err.Error(); got != want {
+		t.Errorf("ErrorResponse.Error() = %q, want %q", got, want)
+	}
+
+	wantErrs := []ValidationError{
+		{Resource: "Issue", Field: "title", Code: "missing_field"},
+		{Resource: "Issue", Field: "milestone", Code: "invalid", Message: "milestone 9 is closed"},
+		{Resource: "Label", Field: "name", Code: "already_exists"},
+	}
+	if got := err.ValidationErrors(); !reflect.DeepEqual(got, wantErrs) {
+		t.Errorf("ErrorResponse.ValidationErrors() = %+v, want %+v", got, wantErrs)
+	}
+
+	wantSuggestions := []string{
+		"title is required",
+		"the value of milestone is not formatted correctly",
+		"another Label already has this name; choose a different value",
+	}
+	for i, e := range err.ValidationErrors() {
+		if got := e.Suggestion(); got != wantSuggestions[i] {
+			t.Errorf("ValidationErrors()[%d].Suggestion() = %q, want %q", i, got, wantSuggestions[i])
+		}
+	}
+}
+
+func TestErrorResponse_ValidationErrors_notValidationFailure(t *testing.T) {
+	res := &http.Response{Request: &http.Request{}, StatusCode: http.StatusBadRequest}
+	err := &ErrorResponse{Response: res, Errors: []Error{{Field: "f", Code: "c"}}}
+	if got := err.ValidationErrors(); got != nil {
+		t.Errorf("ErrorResponse.ValidationErrors() = %+v, want nil", got)
+	}
+}
+
 func TestError_Error(t *testing.T) {
 	err := Error{}
 	if err.Error() == "" {
@@ -1176,7 +1502,8 @@ func TestRateLimits(t *testing.T) {
 		testMethod(t, r, "GET")
 		fmt.Fprint(w, `{"resources":{
 			"core": {"limit":2,"remaining":1,"reset":1372700873},
-			"search": {"limit":3,"remaining":2,"reset":1372700874}
+			"search": {"limit":3,"remaining":2,"reset":1372700874},
+			"graphql": {"limit":4,"remaining":3,"reset":1372700875}
 		}}`)
 	})
 
@@ -1196,6 +1523,11 @@ func TestRateLimits(t *testing.T) {
 			Remaining: 2,
 			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 54, 0, time.UTC).Local()},
 		},
+		GraphQL: &Rate{
+			Limit:     4,
+			Remaining: 3,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 55, 0, time.UTC).Local()},
+		},
 	}
 	if !reflect.DeepEqual(rate, want) {
 		t.Errorf("RateLimits returned %+v, want %+v", rate, want)
@@ -1207,6 +1539,47 @@ func TestRateLimits(t *testing.T) {
 	if got, want := client.rateLimits[searchCategory], *want.Search; got != want {
 		t.Errorf("client.rateLimits[searchCategory] is %+v, want %+v", got, want)
 	}
+	if got, want := client.rateLimits[graphqlCategory], *want.GraphQL; got != want {
+		t.Errorf("client.rateLimits[graphqlCategory] is %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_LastRateLimits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+		w.Header().Set(headerRateReset, "1372700873")
+		fmt.Fprint(w, `{}`)
+	})
+
+	if got, want := client.LastRateLimits(), (&RateLimits{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("LastRateLimits before any request returned %+v, want %+v", got, want)
+	}
+
+	if _, _, err := client.Users.Get(context.Background(), ""); err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+
+	want := &RateLimits{
+		Core: &Rate{
+			Limit:     5000,
+			Remaining: 4999,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 53, 0, time.UTC).Local()},
+		},
+	}
+	got := client.LastRateLimits()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LastRateLimits returned %+v, want %+v", got, want)
+	}
+
+	// The result is a copy.
+	got.Core.Remaining = 0
+	if got := client.LastRateLimits(); !reflect.DeepEqual(got, want) {
+		t.Errorf("LastRateLimits after modifying its result returned %+v, want %+v", got, want)
+	}
 }
 
 func TestSetCredentialsAsHeaders(t *testing.T) {
@@ -1391,3 +1764,47 @@ func TestNestedStructAccessorNoPanic(t *testing.T) {
 		t.Errorf("Issues.Get.GetUser().GetPlan().GetName() returned %+v, want %+v", got, want)
 	}
 }
+
+func TestResponse_SuggestedRetryDelay(t *testing.T) {
+	reset := fmt.Sprint(time.Now().Add(10 * time.Minute).Unix())
+	tests := []struct {
+		name      string
+		status    int
+		header    map[string]string
+		wantDelay time.Duration
+		wantRetry bool
+	}{
+		{"success", http.StatusOK, map[string]string{headerRateRemaining: "0", headerRateReset: reset}, 0, false},
+		{"forbidden", http.StatusForbidden, map[string]string{headerRateRemaining: "10"}, 0, false},
+		{"primary", http.StatusForbidden, map[string]string{headerRateRemaining: "0", headerRateReset: reset}, 10 * time.Minute, true},
+		{"primary 429", http.StatusTooManyRequests, map[string]string{headerRateRemaining: "0", headerRateReset: reset}, 10 * time.Minute, true},
+		{"secondary", http.StatusForbidden, map[string]string{"Retry-After": "30"}, 30 * time.Second, true},
+		{"secondary longer", http.StatusForbidden, map[string]string{"Retry-After": "3600", headerRateRemaining: "0", headerRateReset: reset}, time.Hour, true},
+		{"primary longer", http.StatusForbidden, map[string]string{"Retry-After": "30", headerRateRemaining: "0", headerRateReset: reset}, 10 * time.Minute, true},
+		{"secondary date", http.StatusForbidden, map[string]string{"Retry-After": time.Now().Add(2 * time.Minute).UTC().Format(http.TimeFormat)}, 2 * time.Minute, true},
+		{"too many requests", http.StatusTooManyRequests, map[string]string{}, time.Minute, true},
+		{"reset passed", http.StatusForbidden, map[string]string{headerRateRemaining: "0", headerRateReset: "1"}, 0, true},
+		{"service unavailable", http.StatusServiceUnavailable, map[string]string{"Retry-After": "5"}, 5 * time.Second, true},
+	}
+
+	for _, tt := range tests {
+		header := http.Header{}
+		for k, v := range tt.header {
+			header.Set(k, v)
+		}
+		r := &Response{Response: &http.Response{StatusCode: tt.status, Header: header}}
+		delay, retry := r.SuggestedRetryDelay()
+		if retry != tt.wantRetry {
+			t.Errorf("%v: SuggestedRetryDelay returned retry %v, want %v", tt.name, retry, tt.wantRetry)
+		}
+		// Delays computed from a time are off by up to a second, due to the
+		// precision of the headers.
+		if diff := delay - tt.wantDelay; diff < -time.Second || diff > time.Second {
+			t.Errorf("%v: SuggestedRetryDelay returned delay %v, want %v", tt.name, delay, tt.wantDelay)
+		}
+	}
+
+	if _, retry := (*Response)(nil).SuggestedRetryDelay(); retry {
+		t.Error("SuggestedRetryDelay on a nil Response returned retry true")
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLError represents a single error reported by the GitHub GraphQL API.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/guides/forming-calls-with-graphql
+type GraphQLError struct {
+	Type    string        `json:"type,omitempty"` // error type, e.g. NOT_FOUND or FORBIDDEN
+	Message string        `json:"message"`        // error message
+	Path    []interface{} `json:"path,omitempty"` // path to the field that failed
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Type == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%v: %v", e.Type, e.Message)
+}
+
+// GraphQLErrorResponse is returned when the GitHub GraphQL API responds
+// successfully at the HTTP level but reports errors in the response body.
+type GraphQLErrorResponse struct {
+	Response *http.Response // HTTP response that caused this error
+	Errors   []*GraphQLError
+}
+
+func (r *GraphQLErrorResponse) Error() string {
+	msgs := make([]string, len(r.Errors))
+	for i, e := range r.Errors {
+		msgs[i] = e.Error()
+	}
+	return fmt.Sprintf("%v %v: %d %v", r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
+		r.Response.StatusCode, strings.Join(msgs, "; "))
+}
+
+// graphQL sends the GraphQL query with the given variables to the GraphQL
+// endpoint of the GitHub API and decodes the "data" field of the response
+// into v. If the response reports any errors, a *GraphQLErrorResponse is
+// returned and v is left untouched.
+//
+// The GraphQL endpoint is resolved relative to c.BaseURL, so GitHub
+// Enterprise Server clients whose BaseURL ends in "/api/v3/" use "/api/graphql".
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*Response, error) {
+	u := "graphql"
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		u = "../graphql"
+	}
+
+	body := &struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{query, variables}
+	req, err := c.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []*GraphQLError `json:"errors"`
+	})
+	resp, err := c.Do(ctx, req, result)
+	if err != nil {
+		return resp, err
+	}
+	if len(result.Errors) > 0 {
+		return resp, &GraphQLErrorResponse{Response: resp.Response, Errors: result.Errors}
+	}
+	if v != nil && len(result.Data) > 0 {
+		if err := json.Unmarshal(result.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
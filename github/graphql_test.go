@@ -0,0 +1,61 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_graphQL_enterprise(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		fmt.Fprint(w, `{"data":{"viewer":{"login":"l"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewEnterpriseClient(server.URL+"/api/v3/", server.URL+"/api/uploads/", nil)
+	if err != nil {
+		t.Fatalf("NewEnterpriseClient returned error: %v", err)
+	}
+
+	var result struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+	if _, err := client.graphQL(context.Background(), "{ viewer { login } }", nil, &result); err != nil {
+		t.Fatalf("graphQL returned error: %v", err)
+	}
+	if want := "/api/graphql"; gotPath != want {
+		t.Errorf("graphQL requested path %q, want %q", gotPath, want)
+	}
+	if want := "l"; result.Viewer.Login != want {
+		t.Errorf("graphQL decoded login %q, want %q", result.Viewer.Login, want)
+	}
+}
+
+func TestGraphQLErrorResponse_Error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errors":[{"type":"FORBIDDEN","message":"m1"},{"message":"m2"}]}`)
+	})
+
+	_, err := client.graphQL(context.Background(), "{ viewer { login } }", nil, nil)
+	if err == nil {
+		t.Fatal("graphQL returned no error")
+	}
+	if got, want := err.Error(), ": 200 FORBIDDEN: m1; m2"; !strings.HasSuffix(got, want) {
+		t.Errorf("GraphQLErrorResponse.Error() = %q, want suffix %q", got, want)
+	}
+}
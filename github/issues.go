@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -280,6 +281,109 @@ func (s *IssuesService) Create(ctx context.Context, owner string, repo string, i
 	return i, resp, nil
 }
 
+// IssueRequestByName represents an issue to create with CreateIssueResolved.
+// Unlike IssueRequest, it refers to its milestone by title.
+type IssueRequestByName struct {
+	Title     string
+	Body      string
+	Assignees []string
+
+	// Milestone is the title of the milestone to associate the issue with,
+	// which may be open or closed. If empty, the issue has no milestone.
+	Milestone string
+
+	// Labels are the names of the labels to apply to the issue, matched
+	// case-insensitively against the labels of the repository.
+	Labels []string
+	// CreateMissingLabels specifies whether the labels that do not exist in
+	// the repository are created. If false, an error is returned instead.
+	CreateMissingLabels bool
+}
+
+// CreateIssueResolved creates a new issue on the specified repository from
+// human-friendly input: the milestone title is resolved to its number, and
+// the labels are checked to exist, the missing ones being created if
+// issue.CreateMissingLabels is set. No issue is created if the milestone or
+// a label cannot be resolved.
+//
+// Resolving the milestone and labels lists all of them, so this makes a few
+// more requests than Create. The returned Response is that of the last
+// request made.
+func (s *IssuesService) CreateIssueResolved(ctx context.Context, owner, repo string, issue *IssueRequestByName) (*Issue, *Response, error) {
+	req := &IssueRequest{Title: String(issue.Title)}
+	if issue.Body != "" {
+		req.Body = String(issue.Body)
+	}
+	if len(issue.Assignees) > 0 {
+		req.Assignees = &issue.Assignees
+	}
+
+	if issue.Milestone != "" {
+		milestones, resp, err := s.ListMilestonesAll(ctx, owner, repo, &MilestoneListOptions{State: MilestoneStateAll})
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, m := range milestones {
+			if m.GetTitle() == issue.Milestone {
+				req.Milestone = m.Number
+				break
+			}
+		}
+		if req.Milestone == nil {
+			return nil, resp, fmt.Errorf("milestone %q not found in %v/%v", issue.Milestone, owner, repo)
+		}
+	}
+
+	if len(issue.Labels) > 0 {
+		labels, resp, err := s.resolveLabels(ctx, owner, repo, issue.Labels, issue.CreateMissingLabels)
+		if err != nil {
+			return nil, resp, err
+		}
+		req.Labels = &labels
+	}
+
+	return s.Create(ctx, owner, repo, req)
+}
+
+// resolveLabels returns the names of the existing labels of the repository
+// matching names, creating the missing ones if create is true.
+func (s *IssuesService) resolveLabels(ctx context.Context, owner, repo string, names []string, create bool) ([]string, *Response, error) {
+	existing, resp, err := s.ListLabelsAll(ctx, owner, repo, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	byName := make(map[string]string, len(existing))
+	for _, l := range existing {
+		byName[strings.ToLower(l.GetName())] = l.GetName()
+	}
+
+	var missing []string
+	for _, name := range names {
+		if _, ok := byName[strings.ToLower(name)]; !ok {
+			missing = append(missing, name)
+			byName[strings.ToLower(name)] = ""
+		}
+	}
+	if len(missing) > 0 && !create {
+		return nil, resp, fmt.Errorf("labels not found in %v/%v: %v", owner, repo, strings.Join(missing, ", "))
+	}
+
+	for _, name := range missing {
+		var l *Label
+		l, resp, err = s.CreateLabel(ctx, owner, repo, &Label{Name: String(name)})
+		if err != nil {
+			return nil, resp, err
+		}
+		byName[strings.ToLower(name)] = l.GetName()
+	}
+
+	resolved := make([]string, len(names))
+	for i, name := range names {
+		resolved[i] = byName[strings.ToLower(name)]
+	}
+	return resolved, resp, nil
+}
+
 // Edit an issue.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#update-an-issue
@@ -299,6 +403,14 @@ func (s *IssuesService) Edit(ctx context.Context, owner string, repo string, num
 	return i, resp, nil
 }
 
+// Lock reasons accepted by IssuesService.Lock.
+const (
+	LockReasonOffTopic  = "off-topic"
+	LockReasonTooHeated = "too heated"
+	LockReasonResolved  = "resolved"
+	LockReasonSpam      = "spam"
+)
+
 // LockIssueOptions specifies the optional parameters to the
 // IssuesService.Lock method.
 type LockIssueOptions struct {
@@ -308,10 +420,22 @@ type LockIssueOptions struct {
 	LockReason string `json:"lock_reason,omitempty"`
 }
 
-// Lock an issue's conversation.
+// Lock an issue's conversation. Since pull requests are issues, number can
+// also be the number of a pull request, whose conversation is then locked.
+//
+// An unknown LockReason is rejected without making a request, as GitHub
+// would reject it with 422 Unprocessable Entity.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#lock-an-issue
 func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, number int, opts *LockIssueOptions) (*Response, error) {
+	if opts != nil {
+		switch opts.LockReason {
+		case "", LockReasonOffTopic, LockReasonTooHeated, LockReasonResolved, LockReasonSpam:
+		default:
+			return nil, fmt.Errorf("invalid lock reason %q", opts.LockReason)
+		}
+	}
+
 	u := fmt.Sprintf("repos/%v/%v/issues/%d/lock", owner, repo, number)
 	req, err := s.client.NewRequest("PUT", u, opts)
 	if err != nil {
@@ -321,7 +445,8 @@ func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, num
 	return s.client.Do(ctx, req, nil)
 }
 
-// Unlock an issue's conversation.
+// Unlock an issue's conversation. As with Lock, number can also be the
+// number of a pull request.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#unlock-an-issue
 func (s *IssuesService) Unlock(ctx context.Context, owner string, repo string, number int) (*Response, error) {
@@ -333,3 +458,80 @@ func (s *IssuesService) Unlock(ctx context.Context, owner string, repo string, n
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// transferIssueMutation moves an issue to another repository.
+const transferIssueMutation = `mutation($issueId: ID!, $repositoryId: ID!) {
+  transferIssue(input: {issueId: $issueId, repositoryId: $repositoryId}) {
+    issue {
+      id
+      number
+      url
+      repository {
+        id
+        name
+        nameWithOwner
+        owner { login }
+      }
+    }
+  }
+}`
+
+// TransferIssue transfers an issue to another repository owned by the same
+// user or organization. targetRepositoryID is the GraphQL node ID of the
+// destination repository, as found in Repository.NodeID.
+//
+// Transferring issues is only supported by the GraphQL API, so this method
+// looks up the issue's node ID with Get and then runs the transferIssue
+// mutation. The returned Issue describes the transferred issue in its new
+// repository; only its NodeID, Number, HTMLURL and Repository fields are
+// populated.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#transferissue
+func (s *IssuesService) TransferIssue(ctx context.Context, owner string, repo string, number int, targetRepositoryID string) (*Issue, *Response, error) {
+	issue, resp, err := s.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var result struct {
+		TransferIssue struct {
+			Issue *struct {
+				ID         string `json:"id"`
+				Number     int    `json:"number"`
+				URL        string `json:"url"`
+				Repository struct {
+					ID            string `json:"id"`
+					Name          string `json:"name"`
+					NameWithOwner string `json:"nameWithOwner"`
+					Owner         struct {
+						Login string `json:"login"`
+					} `json:"owner"`
+				} `json:"repository"`
+			} `json:"issue"`
+		} `json:"transferIssue"`
+	}
+	vars := map[string]interface{}{
+		"issueId":      issue.GetNodeID(),
+		"repositoryId": targetRepositoryID,
+	}
+	resp, err = s.client.graphQL(ctx, transferIssueMutation, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	transferred := result.TransferIssue.Issue
+	if transferred == nil {
+		return nil, resp, fmt.Errorf("transferring issue %v/%v#%d returned no issue", owner, repo, number)
+	}
+	return &Issue{
+		NodeID:  String(transferred.ID),
+		Number:  Int(transferred.Number),
+		HTMLURL: String(transferred.URL),
+		Repository: &Repository{
+			NodeID:   String(transferred.Repository.ID),
+			Name:     String(transferred.Repository.Name),
+			FullName: String(transferred.Repository.NameWithOwner),
+			Owner:    &User{Login: String(transferred.Repository.Owner.Login)},
+		},
+	}, resp, nil
+}
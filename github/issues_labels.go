@@ -49,6 +49,27 @@ func (s *IssuesService) ListLabels(ctx context.Context, owner string, repo strin
 	return labels, resp, nil
 }
 
+// ListLabelsAll lists all labels for a repository, following pagination
+// until every page has been fetched. opts.Page is used as the first page to
+// fetch.
+//
+// If a request fails, the labels fetched so far are returned along with the
+// error.
+func (s *IssuesService) ListLabelsAll(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Label, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Label
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		labels, resp, err := s.ListLabels(ctx, owner, repo, o)
+		all = append(all, labels...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetLabel gets a single label.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#get-a-label
@@ -35,16 +35,49 @@ func (m Milestone) String() string {
 	return Stringify(m)
 }
 
+// Progress returns the percentage of the milestone's issues that are closed,
+// from 0 to 100. A milestone without issues has a progress of 0.
+func (m *Milestone) Progress() float64 {
+	open, closed := m.GetOpenIssues(), m.GetClosedIssues()
+	if open+closed == 0 {
+		return 0
+	}
+	return float64(closed) / float64(open+closed) * 100
+}
+
+// MilestoneState is used to filter milestones by state when listing them.
+type MilestoneState string
+
+const (
+	// MilestoneStateOpen lists open milestones.
+	MilestoneStateOpen MilestoneState = "open"
+	// MilestoneStateClosed lists closed milestones.
+	MilestoneStateClosed MilestoneState = "closed"
+	// MilestoneStateAll lists milestones regardless of state.
+	MilestoneStateAll MilestoneState = "all"
+)
+
+// MilestoneSort is used to order milestones when listing them.
+type MilestoneSort string
+
+const (
+	// MilestoneSortDueOn sorts milestones by due date.
+	MilestoneSortDueOn MilestoneSort = "due_on"
+	// MilestoneSortCompleteness sorts milestones by the percentage of
+	// their issues that are closed.
+	MilestoneSortCompleteness MilestoneSort = "completeness"
+)
+
 // MilestoneListOptions specifies the optional parameters to the
 // IssuesService.ListMilestones method.
 type MilestoneListOptions struct {
 	// State filters milestones based on their state. Possible values are:
 	// open, closed, all. Default is "open".
-	State string `url:"state,omitempty"`
+	State MilestoneState `url:"state,omitempty"`
 
 	// Sort specifies how to sort milestones. Possible values are: due_on, completeness.
 	// Default value is "due_on".
-	Sort string `url:"sort,omitempty"`
+	Sort MilestoneSort `url:"sort,omitempty"`
 
 	// Direction in which to sort milestones. Possible values are: asc, desc.
 	// Default is "asc".
@@ -77,6 +110,27 @@ func (s *IssuesService) ListMilestones(ctx context.Context, owner string, repo s
 	return milestones, resp, nil
 }
 
+// ListMilestonesAll lists all milestones for a repository, following
+// pagination until every page has been fetched. opts.Page is used as the
+// first page to fetch.
+//
+// If a request fails, the milestones fetched so far are returned along with
+// the error.
+func (s *IssuesService) ListMilestonesAll(ctx context.Context, owner string, repo string, opts *MilestoneListOptions) ([]*Milestone, *Response, error) {
+	o := new(MilestoneListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Milestone
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		milestones, resp, err := s.ListMilestones(ctx, owner, repo, o)
+		all = append(all, milestones...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetMilestone gets a single milestone.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/issues/#get-a-milestone
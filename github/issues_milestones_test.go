@@ -41,6 +41,60 @@ func TestIssuesService_ListMilestones(t *testing.T) {
 	}
 }
 
+func TestIssuesService_ListMilestonesAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/milestones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.FormValue("sort"), "completeness"; got != want {
+			t.Errorf("sort = %q, want %q", got, want)
+		}
+		if got, want := r.FormValue("state"), "all"; got != want {
+			t.Errorf("state = %q, want %q", got, want)
+		}
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/milestones?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"number":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &MilestoneListOptions{State: MilestoneStateAll, Sort: MilestoneSortCompleteness}
+	milestones, _, err := client.Issues.ListMilestonesAll(context.Background(), "o", "r", opts)
+	if err != nil {
+		t.Errorf("IssuesService.ListMilestonesAll returned error: %v", err)
+	}
+
+	want := []*Milestone{{Number: Int(1)}, {Number: Int(2)}}
+	if !reflect.DeepEqual(milestones, want) {
+		t.Errorf("IssuesService.ListMilestonesAll returned %+v, want %+v", milestones, want)
+	}
+}
+
+func TestMilestone_Progress(t *testing.T) {
+	tests := []struct {
+		milestone *Milestone
+		want      float64
+	}{
+		{&Milestone{OpenIssues: Int(1), ClosedIssues: Int(3)}, 75},
+		{&Milestone{OpenIssues: Int(4), ClosedIssues: Int(0)}, 0},
+		{&Milestone{OpenIssues: Int(0), ClosedIssues: Int(2)}, 100},
+		{&Milestone{}, 0},
+		{nil, 0},
+	}
+
+	for _, test := range tests {
+		if got := test.milestone.Progress(); got != test.want {
+			t.Errorf("Progress of %v = %v, want %v", test.milestone, got, test.want)
+		}
+	}
+}
+
 func TestIssuesService_ListMilestones_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
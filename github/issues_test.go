@@ -217,6 +217,90 @@ func TestIssuesService_Create(t *testing.T) {
 	}
 }
 
+func TestIssuesService_CreateIssueResolved(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/milestones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "all"})
+		fmt.Fprint(w, `[{"number":1,"title":"v1.0"},{"number":2,"title":"v2.0"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[{"name":"bug"},{"name":"Needs Triage"}]`)
+		case "POST":
+			testBody(t, r, `{"name":"customer"}`+"\n")
+			fmt.Fprint(w, `{"name":"customer"}`)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"t","body":"b","labels":["Needs Triage","customer","bug"],"milestone":2,"assignees":["a"]}`+"\n")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	input := &IssueRequestByName{
+		Title:               "t",
+		Body:                "b",
+		Assignees:           []string{"a"},
+		Milestone:           "v2.0",
+		Labels:              []string{"needs triage", "customer", "bug"},
+		CreateMissingLabels: true,
+	}
+	issue, _, err := client.Issues.CreateIssueResolved(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Fatalf("Issues.CreateIssueResolved returned error: %v", err)
+	}
+
+	want := &Issue{Number: Int(1)}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("Issues.CreateIssueResolved returned %+v, want %+v", issue, want)
+	}
+}
+
+func TestIssuesService_CreateIssueResolved_milestoneNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/milestones", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"number":1,"title":"v1.0"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("issue created despite unknown milestone")
+	})
+
+	_, _, err := client.Issues.CreateIssueResolved(context.Background(), "o", "r", &IssueRequestByName{Title: "t", Milestone: "v3.0"})
+	if err == nil {
+		t.Error("Issues.CreateIssueResolved returned nil error, want an error")
+	}
+}
+
+func TestIssuesService_CreateIssueResolved_missingLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"bug"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("issue created despite missing labels")
+	})
+
+	input := &IssueRequestByName{Title: "t", Labels: []string{"bug", "customer", "urgent"}}
+	_, _, err := client.Issues.CreateIssueResolved(context.Background(), "o", "r", input)
+	if err == nil {
+		t.Fatal("Issues.CreateIssueResolved returned nil error, want an error")
+	}
+	if want := "labels not found in o/r: customer, urgent"; err.Error() != want {
+		t.Errorf("Issues.CreateIssueResolved returned error %q, want %q", err, want)
+	}
+}
+
 func TestIssuesService_Create_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -293,6 +377,37 @@ func TestIssuesService_LockWithReason(t *testing.T) {
 	}
 }
 
+func TestIssuesService_Lock_pullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// Pull requests share the issue number space and lock endpoint.
+	mux.HandleFunc("/repos/o/r/issues/42/lock", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"lock_reason":"resolved"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opt := &LockIssueOptions{LockReason: LockReasonResolved}
+	if _, err := client.Issues.Lock(context.Background(), "o", "r", 42, opt); err != nil {
+		t.Errorf("Issues.Lock returned error: %v", err)
+	}
+}
+
+func TestIssuesService_Lock_invalidReason(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Issues.Lock made a request with an invalid lock reason")
+	})
+
+	opt := &LockIssueOptions{LockReason: "heated"}
+	if _, err := client.Issues.Lock(context.Background(), "o", "r", 1, opt); err == nil {
+		t.Error("Issues.Lock returned nil error, want an error")
+	}
+}
+
 func TestIssuesService_Unlock(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -318,3 +433,71 @@ func TestIsPullRequest(t *testing.T) {
 		t.Errorf("expected i.IsPullRequest (%v) to return true, got false", i)
 	}
 }
+
+func TestIssuesService_TransferIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"node_id":"I_1"}`)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body struct {
+			Query     string            `json:"query"`
+			Variables map[string]string `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding GraphQL request: %v", err)
+		}
+		if body.Query != transferIssueMutation {
+			t.Errorf("GraphQL query is %q, want %q", body.Query, transferIssueMutation)
+		}
+		if want := map[string]string{"issueId": "I_1", "repositoryId": "R_2"}; !reflect.DeepEqual(body.Variables, want) {
+			t.Errorf("GraphQL variables are %+v, want %+v", body.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"transferIssue":{"issue":{"id":"I_1","number":7,"url":"https://github.com/o/t/issues/7","repository":{"id":"R_2","name":"t","nameWithOwner":"o/t","owner":{"login":"o"}}}}}}`)
+	})
+
+	issue, _, err := client.Issues.TransferIssue(context.Background(), "o", "r", 1, "R_2")
+	if err != nil {
+		t.Fatalf("Issues.TransferIssue returned error: %v", err)
+	}
+
+	want := &Issue{
+		NodeID:  String("I_1"),
+		Number:  Int(7),
+		HTMLURL: String("https://github.com/o/t/issues/7"),
+		Repository: &Repository{
+			NodeID:   String("R_2"),
+			Name:     String("t"),
+			FullName: String("o/t"),
+			Owner:    &User{Login: String("o")},
+		},
+	}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("Issues.TransferIssue returned %+v, want %+v", issue, want)
+	}
+}
+
+func TestIssuesService_TransferIssue_graphQLError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number":1,"node_id":"I_1"}`)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"transferIssue":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a node with the global id of 'R_x'."}]}`)
+	})
+
+	_, _, err := client.Issues.TransferIssue(context.Background(), "o", "r", 1, "R_x")
+	gqlErr, ok := err.(*GraphQLErrorResponse)
+	if !ok {
+		t.Fatalf("Issues.TransferIssue returned error %#v, want *GraphQLErrorResponse", err)
+	}
+	if got, want := gqlErr.Errors[0].Type, "NOT_FOUND"; got != want {
+		t.Errorf("GraphQL error type is %q, want %q", got, want)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -32,6 +33,14 @@ const (
 	sha512Prefix = "sha512"
 	// signatureHeader is the GitHub header key used to pass the HMAC hexdigest.
 	signatureHeader = "X-Hub-Signature"
+	// sha256SignatureHeader is the GitHub header key used to pass the HMAC-SHA256 hexdigest.
+	sha256SignatureHeader = "X-Hub-Signature-256"
+	// hookIDHeader is the GitHub header key used to pass the ID of the webhook.
+	hookIDHeader = "X-Github-Hook-Id"
+	// targetTypeHeader is the GitHub header key used to pass the type of resource the webhook is installed on.
+	targetTypeHeader = "X-Github-Hook-Installation-Target-Type"
+	// targetIDHeader is the GitHub header key used to pass the ID of the resource the webhook is installed on.
+	targetIDHeader = "X-Github-Hook-Installation-Target-Id"
 	// eventTypeHeader is the GitHub header key used to pass the event type.
 	eventTypeHeader = "X-Github-Event"
 	// deliveryIDHeader is the GitHub header key used to pass the unique ID for the webhook event.
@@ -78,7 +87,9 @@ var (
 		"push":                           "PushEvent",
 		"repository":                     "RepositoryEvent",
 		"repository_dispatch":            "RepositoryDispatchEvent",
+		"repository_ruleset":             "RepositoryRulesetEvent",
 		"repository_vulnerability_alert": "RepositoryVulnerabilityAlertEvent",
+		"registry_package":               "RegistryPackageEvent",
 		"release":                        "ReleaseEvent",
 		"star":                           "StarEvent",
 		"status":                         "StatusEvent",
@@ -137,6 +148,8 @@ func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 
 // ValidatePayload validates an incoming GitHub Webhook event request
 // and returns the (JSON) payload.
+// The signature in the X-Hub-Signature-256 header is checked if present,
+// otherwise the one in the X-Hub-Signature header.
 // The Content-Type header of the payload can be "application/json" or "application/x-www-form-urlencoded".
 // If the Content-Type is neither then an error is returned.
 // secretToken is the GitHub Webhook secret token.
@@ -190,7 +203,10 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 	// Only validate the signature if a secret token exists. This is intended for
 	// local development only and all webhooks should ideally set up a secret token.
 	if len(secretToken) > 0 {
-		sig := r.Header.Get(signatureHeader)
+		sig := r.Header.Get(sha256SignatureHeader)
+		if sig == "" {
+			sig = r.Header.Get(signatureHeader)
+		}
 		if err := ValidateSignature(sig, body, secretToken); err != nil {
 			return nil, err
 		}
@@ -200,7 +216,8 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 }
 
 // ValidateSignature validates the signature for the given payload.
-// signature is the GitHub hash signature delivered in the X-Hub-Signature header.
+// signature is the GitHub hash signature delivered in the X-Hub-Signature or
+// X-Hub-Signature-256 header.
 // payload is the JSON payload sent by GitHub Webhooks.
 // secretToken is the GitHub Webhook secret token.
 //
@@ -230,6 +247,112 @@ func DeliveryID(r *http.Request) string {
 	return r.Header.Get(deliveryIDHeader)
 }
 
+// WebHookHeaders holds the headers GitHub sends with each webhook delivery.
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#delivery-headers
+type WebHookHeaders struct {
+	Event      string // X-GitHub-Event: the event type, such as "push".
+	DeliveryID string // X-GitHub-Delivery: the unique ID of the delivery.
+	HookID     int64  // X-GitHub-Hook-ID: the ID of the webhook, or 0 if not sent.
+
+	// TargetType and TargetID identify the resource the webhook is
+	// installed on, such as "repository" and its ID. They are empty if not
+	// sent.
+	TargetType string // X-GitHub-Hook-Installation-Target-Type
+	TargetID   int64  // X-GitHub-Hook-Installation-Target-ID
+
+	Signature256 string // X-Hub-Signature-256: the HMAC-SHA256 signature of the payload, if any.
+	Signature    string // X-Hub-Signature: the HMAC-SHA1 signature of the payload, if any.
+}
+
+// ParseWebHookHeaders reads the webhook delivery headers from h. An error is
+// returned if the event type or delivery ID is missing, or if a header is
+// malformed. Signatures are only checked for their format here; use
+// ValidatePayload or ReceiveWebHook to verify them against the payload.
+func ParseWebHookHeaders(h http.Header) (*WebHookHeaders, error) {
+	headers := &WebHookHeaders{
+		Event:        h.Get(eventTypeHeader),
+		DeliveryID:   h.Get(deliveryIDHeader),
+		TargetType:   h.Get(targetTypeHeader),
+		Signature256: h.Get(sha256SignatureHeader),
+		Signature:    h.Get(signatureHeader),
+	}
+	if headers.Event == "" {
+		return nil, fmt.Errorf("missing %v header", eventTypeHeader)
+	}
+	if headers.DeliveryID == "" {
+		return nil, fmt.Errorf("missing %v header", deliveryIDHeader)
+	}
+
+	var err error
+	if headers.HookID, err = parseWebHookIDHeader(h, hookIDHeader); err != nil {
+		return nil, err
+	}
+	if headers.TargetID, err = parseWebHookIDHeader(h, targetIDHeader); err != nil {
+		return nil, err
+	}
+
+	if headers.Signature256 != "" && !strings.HasPrefix(headers.Signature256, sha256Prefix+"=") {
+		return nil, fmt.Errorf("malformed %v header %q", sha256SignatureHeader, headers.Signature256)
+	}
+	if headers.Signature != "" && !strings.HasPrefix(headers.Signature, sha1Prefix+"=") {
+		return nil, fmt.Errorf("malformed %v header %q", signatureHeader, headers.Signature)
+	}
+
+	return headers, nil
+}
+
+// parseWebHookIDHeader parses the numeric ID in header key of h, returning 0
+// if it is not set.
+func parseWebHookIDHeader(h http.Header, key string) (int64, error) {
+	v := h.Get(key)
+	if v == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("malformed %v header %q", key, v)
+	}
+	return id, nil
+}
+
+// ReceiveWebHook handles an incoming webhook request in one call: it parses
+// its headers with ParseWebHookHeaders, validates its payload against
+// secretToken with ValidatePayload, and parses the payload with ParseWebHook.
+// As with ValidatePayload, an empty secretToken skips signature validation,
+// which should only be done during development.
+//
+// If the headers could be parsed, they are returned even when a later step
+// fails, so that the delivery can be identified when reporting the error.
+//
+// Example usage:
+//
+//	func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//		event, headers, err := github.ReceiveWebHook(r, s.webhookSecretKey)
+//		if err != nil { ... }
+//		switch event := event.(type) {
+//		case *github.PushEvent:
+//			processPushEvent(headers.DeliveryID, event)
+//		}
+//	}
+func ReceiveWebHook(r *http.Request, secretToken []byte) (event interface{}, headers *WebHookHeaders, err error) {
+	headers, err = ParseWebHookHeaders(r.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload, err := ValidatePayload(r, secretToken)
+	if err != nil {
+		return nil, headers, err
+	}
+
+	event, err = ParseWebHook(headers.Event, payload)
+	if err != nil {
+		return nil, headers, err
+	}
+	return event, headers, nil
+}
+
 // ParseWebHook parses the event payload. For recognized event types, a
 // value of the corresponding struct type will be returned (as returned
 // by Event.ParsePayload()). An error will be returned for unrecognized event
@@ -263,3 +386,26 @@ func ParseWebHook(messageType string, payload []byte) (interface{}, error) {
 	}
 	return event.ParsePayload()
 }
+
+// InstallationIDFromPayload returns the ID of the GitHub App installation
+// that a webhook event payload was delivered for, as found in its
+// installation.id field. Apps use it to choose which installation to
+// authenticate as when handling the event, for example with
+// AppsService.NewInstallationClient.
+//
+// An error is returned if the payload is not valid JSON or was not delivered
+// to an app installation.
+func InstallationIDFromPayload(payload []byte) (int64, error) {
+	var p struct {
+		Installation *struct {
+			ID *int64 `json:"id"`
+		} `json:"installation"`
+	}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return 0, err
+	}
+	if p.Installation == nil || p.Installation.ID == nil {
+		return 0, errors.New("payload has no installation ID")
+	}
+	return *p.Installation.ID, nil
+}
@@ -7,6 +7,8 @@ package github
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -323,6 +325,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &PushEvent{},
 			messageType: "push",
 		},
+		{
+			payload:     &RegistryPackageEvent{},
+			messageType: "registry_package",
+		},
 		{
 			payload:     &ReleaseEvent{},
 			messageType: "release",
@@ -331,6 +337,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &RepositoryEvent{},
 			messageType: "repository",
 		},
+		{
+			payload:     &RepositoryRulesetEvent{},
+			messageType: "repository_ruleset",
+		},
 		{
 			payload:     &RepositoryVulnerabilityAlertEvent{},
 			messageType: "repository_vulnerability_alert",
@@ -401,3 +411,151 @@ func TestDeliveryID(t *testing.T) {
 		t.Errorf("DeliveryID(%#v) = %q, want %q", req, got, id)
 	}
 }
+
+func TestInstallationIDFromPayload(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    int64
+		wantErr bool
+	}{
+		{payload: `{"action":"opened","installation":{"id":42,"node_id":"MDIz"}}`, want: 42},
+		{payload: `{"action":"opened"}`, wantErr: true},
+		{payload: `{"installation":{}}`, wantErr: true},
+		{payload: `not json`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := InstallationIDFromPayload([]byte(test.payload))
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("InstallationIDFromPayload(%q) returned %v, want error", test.payload, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("InstallationIDFromPayload(%q) returned error: %v", test.payload, err)
+		}
+		if got != test.want {
+			t.Errorf("InstallationIDFromPayload(%q) returned %v, want %v", test.payload, got, test.want)
+		}
+	}
+}
+
+func TestParseWebHookHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-GitHub-Event", "push")
+	h.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	h.Set("X-GitHub-Hook-ID", "292430182")
+	h.Set("X-GitHub-Hook-Installation-Target-Type", "repository")
+	h.Set("X-GitHub-Hook-Installation-Target-ID", "79929171")
+	h.Set("X-Hub-Signature-256", "sha256=d57c68ca6f92289e6987922ff26938930f6e66a2d161ef06abdf1859230aa23c")
+	h.Set("X-Hub-Signature", "sha1=7d38cdd689735b008b3c702edd92eea23791c5f6")
+
+	got, err := ParseWebHookHeaders(h)
+	if err != nil {
+		t.Fatalf("ParseWebHookHeaders returned error: %v", err)
+	}
+
+	want := &WebHookHeaders{
+		Event:        "push",
+		DeliveryID:   "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		HookID:       292430182,
+		TargetType:   "repository",
+		TargetID:     79929171,
+		Signature256: "sha256=d57c68ca6f92289e6987922ff26938930f6e66a2d161ef06abdf1859230aa23c",
+		Signature:    "sha1=7d38cdd689735b008b3c702edd92eea23791c5f6",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHookHeaders returned %+v, want %+v", got, want)
+	}
+}
+
+func TestParseWebHookHeaders_invalid(t *testing.T) {
+	valid := map[string]string{
+		"X-GitHub-Event":    "push",
+		"X-GitHub-Delivery": "d",
+	}
+	tests := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"missing event", "X-GitHub-Event", ""},
+		{"missing delivery", "X-GitHub-Delivery", ""},
+		{"bad hook ID", "X-GitHub-Hook-ID", "abc"},
+		{"bad target ID", "X-GitHub-Hook-Installation-Target-ID", "-"},
+		{"bad sha256 signature", "X-Hub-Signature-256", "sha1=abc"},
+		{"bad sha1 signature", "X-Hub-Signature", "abc"},
+	}
+
+	for _, test := range tests {
+		h := http.Header{}
+		for k, v := range valid {
+			h.Set(k, v)
+		}
+		if test.value == "" {
+			h.Del(test.key)
+		} else {
+			h.Set(test.key, test.value)
+		}
+		if _, err := ParseWebHookHeaders(h); err == nil {
+			t.Errorf("ParseWebHookHeaders with %v returned no error", test.name)
+		}
+	}
+}
+
+func newWebHookRequest(t *testing.T, event, body string, secret []byte) *http.Request {
+	req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", "d")
+	req.Header.Set("X-GitHub-Hook-ID", "1")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(genMAC([]byte(body), secret, sha256.New)))
+	return req
+}
+
+func TestReceiveWebHook(t *testing.T) {
+	secret := []byte("0123456789abcdef")
+	req := newWebHookRequest(t, "push", `{"ref":"refs/heads/main"}`, secret)
+	// A stale SHA-1 signature is ignored in favor of the SHA-256 one.
+	req.Header.Set("X-Hub-Signature", "sha1=0000")
+
+	event, headers, err := ReceiveWebHook(req, secret)
+	if err != nil {
+		t.Fatalf("ReceiveWebHook returned error: %v", err)
+	}
+
+	if want := (&PushEvent{Ref: String("refs/heads/main")}); !reflect.DeepEqual(event, want) {
+		t.Errorf("ReceiveWebHook returned event %+v, want %+v", event, want)
+	}
+	if headers.Event != "push" || headers.DeliveryID != "d" || headers.HookID != 1 {
+		t.Errorf("ReceiveWebHook returned headers %+v", headers)
+	}
+}
+
+func TestReceiveWebHook_badSignature(t *testing.T) {
+	req := newWebHookRequest(t, "push", `{"ref":"refs/heads/main"}`, []byte("wrong secret"))
+
+	event, headers, err := ReceiveWebHook(req, []byte("0123456789abcdef"))
+	if err == nil {
+		t.Fatal("ReceiveWebHook returned no error, want signature error")
+	}
+	if event != nil {
+		t.Errorf("ReceiveWebHook returned event %+v, want nil", event)
+	}
+	if headers == nil || headers.DeliveryID != "d" {
+		t.Errorf("ReceiveWebHook returned headers %+v, want the parsed headers", headers)
+	}
+}
+
+func TestReceiveWebHook_missingHeaders(t *testing.T) {
+	req := newWebHookRequest(t, "push", `{}`, nil)
+	req.Header.Del("X-GitHub-Delivery")
+
+	if _, headers, err := ReceiveWebHook(req, nil); err == nil || headers != nil {
+		t.Errorf("ReceiveWebHook returned headers %+v and error %v, want an error only", headers, err)
+	}
+}
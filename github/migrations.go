@@ -35,11 +35,29 @@ type Migration struct {
 	LockRepositories *bool `json:"lock_repositories,omitempty"`
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
-	ExcludeAttachments *bool         `json:"exclude_attachments,omitempty"`
-	URL                *string       `json:"url,omitempty"`
-	CreatedAt          *string       `json:"created_at,omitempty"`
-	UpdatedAt          *string       `json:"updated_at,omitempty"`
-	Repositories       []*Repository `json:"repositories,omitempty"`
+	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+	// ExcludeReleases indicates whether releases are excluded from the
+	// migration.
+	ExcludeReleases *bool `json:"exclude_releases,omitempty"`
+	// ExcludeOwnerProjects indicates whether projects owned by the
+	// organization or users are excluded from the migration.
+	ExcludeOwnerProjects *bool `json:"exclude_owner_projects,omitempty"`
+	// ExcludeMetadata indicates whether metadata other than the Git data
+	// is excluded from the migration.
+	ExcludeMetadata *bool `json:"exclude_metadata,omitempty"`
+	// ExcludeGitData indicates whether the Git data of the repositories is
+	// excluded from the migration.
+	ExcludeGitData *bool `json:"exclude_git_data,omitempty"`
+	// OrgMetadataOnly indicates whether only the metadata of the
+	// organization is migrated, and no repositories.
+	OrgMetadataOnly *bool `json:"org_metadata_only,omitempty"`
+	// Exclude lists the related items excluded from the migration. The only
+	// possible value is "repositories".
+	Exclude      []string      `json:"exclude,omitempty"`
+	URL          *string       `json:"url,omitempty"`
+	CreatedAt    *string       `json:"created_at,omitempty"`
+	UpdatedAt    *string       `json:"updated_at,omitempty"`
+	Repositories []*Repository `json:"repositories,omitempty"`
 }
 
 func (m Migration) String() string {
@@ -55,6 +73,30 @@ type MigrationOptions struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// ExcludeReleases indicates whether releases should be excluded from the
+	// migration (to reduce migration archive file size).
+	ExcludeReleases bool
+
+	// ExcludeOwnerProjects indicates whether projects owned by the
+	// organization or users should be excluded from the migration.
+	ExcludeOwnerProjects bool
+
+	// ExcludeMetadata indicates whether metadata other than the Git data
+	// should be excluded from the migration.
+	ExcludeMetadata bool
+
+	// ExcludeGitData indicates whether the Git data of the repositories
+	// should be excluded from the migration.
+	ExcludeGitData bool
+
+	// OrgMetadataOnly indicates whether only the metadata of the
+	// organization should be migrated. repos must then be empty.
+	OrgMetadataOnly bool
+
+	// Exclude lists related items to exclude from the migration. The only
+	// possible value is "repositories".
+	Exclude []string
 }
 
 // startMigration represents the body of a StartMigration request.
@@ -69,6 +111,13 @@ type startMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	ExcludeReleases      *bool    `json:"exclude_releases,omitempty"`
+	ExcludeOwnerProjects *bool    `json:"exclude_owner_projects,omitempty"`
+	ExcludeMetadata      *bool    `json:"exclude_metadata,omitempty"`
+	ExcludeGitData       *bool    `json:"exclude_git_data,omitempty"`
+	OrgMetadataOnly      *bool    `json:"org_metadata_only,omitempty"`
+	Exclude              []string `json:"exclude,omitempty"`
 }
 
 // StartMigration starts the generation of a migration archive.
@@ -82,6 +131,12 @@ func (s *MigrationService) StartMigration(ctx context.Context, org string, repos
 	if opts != nil {
 		body.LockRepositories = Bool(opts.LockRepositories)
 		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		body.ExcludeReleases = Bool(opts.ExcludeReleases)
+		body.ExcludeOwnerProjects = Bool(opts.ExcludeOwnerProjects)
+		body.ExcludeMetadata = Bool(opts.ExcludeMetadata)
+		body.ExcludeGitData = Bool(opts.ExcludeGitData)
+		body.OrgMetadataOnly = Bool(opts.OrgMetadataOnly)
+		body.Exclude = opts.Exclude
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
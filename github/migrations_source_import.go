@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 )
 
 // Import represents a repository import request.
@@ -251,6 +253,38 @@ func (s *MigrationService) MapCommitAuthor(ctx context.Context, owner, repo stri
 	return out, resp, nil
 }
 
+// MapCommitAuthors updates the identities of several authors for the import,
+// keyed by author ID. The returned map holds the updated author for each
+// mapping that succeeded.
+//
+// One MapCommitAuthor request is made per author, with a bounded number of
+// requests in flight at once. If any of the updates fail, the successful
+// updates are returned along with a *BatchError, keyed by author ID,
+// describing the failures.
+func (s *MigrationService) MapCommitAuthors(ctx context.Context, owner, repo string, authors map[int64]*SourceImportAuthor) (map[int64]*SourceImportAuthor, error) {
+	ids := make([]int64, 0, len(authors))
+	keys := make([]string, 0, len(authors))
+	for id := range authors {
+		ids = append(ids, id)
+		keys = append(keys, strconv.FormatInt(id, 10))
+	}
+
+	updated := make([]*SourceImportAuthor, len(ids))
+	err := forEachConcurrently(ctx, keys, func(i int) error {
+		var err error
+		updated[i], _, err = s.MapCommitAuthor(ctx, owner, repo, ids[i], authors[ids[i]])
+		return err
+	})
+
+	result := make(map[int64]*SourceImportAuthor, len(ids))
+	for i, id := range ids {
+		if updated[i] != nil {
+			result[id] = updated[i]
+		}
+	}
+	return result, err
+}
+
 // SetLFSPreference sets whether imported repositories should use Git LFS for
 // files larger than 100MB. Only the UseLFS field on the provided Import is
 // used.
@@ -303,3 +337,49 @@ func (s *MigrationService) CancelImport(ctx context.Context, owner, repo string)
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// defaultImportPollInterval is the interval WaitForImport uses when called
+// with a non-positive pollInterval.
+const defaultImportPollInterval = 5 * time.Second
+
+// importInProgress reports whether status is one of the import statuses
+// during which GitHub is still working without any input from the user.
+func importInProgress(status string) bool {
+	switch status {
+	case "detecting", "importing", "mapping", "pushing":
+		return true
+	}
+	return false
+}
+
+// WaitForImport polls ImportProgress until the import of the specified
+// repository is no longer in progress, waiting pollInterval between
+// requests, and returns its final status. A non-positive pollInterval waits
+// five seconds. The returned Import's Status is
+// "complete" when the import succeeded, or one of "auth_failed", "error",
+// "detection_needs_auth", "detection_found_nothing" or
+// "detection_found_multiple" when the import needs attention; the import
+// can then be resumed with UpdateImport.
+//
+// Polling stops early if ctx is done, in which case the last status seen is
+// returned along with ctx.Err().
+func (s *MigrationService) WaitForImport(ctx context.Context, owner, repo string, pollInterval time.Duration) (*Import, *Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultImportPollInterval
+	}
+
+	for {
+		imp, resp, err := s.ImportProgress(ctx, owner, repo)
+		if err != nil || !importInProgress(imp.GetStatus()) {
+			return imp, resp, err
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return imp, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
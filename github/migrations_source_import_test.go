@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestMigrationService_StartImport(t *testing.T) {
@@ -151,6 +152,41 @@ func TestMigrationService_MapCommitAuthor(t *testing.T) {
 	}
 }
 
+func TestMigrationService_MapCommitAuthors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/import/authors/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"email":"a@example.com","name":"a"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"email":"a@example.com","name":"a"}`)
+	})
+	mux.HandleFunc("/repos/o/r/import/authors/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	authors := map[int64]*SourceImportAuthor{
+		1: {Name: String("a"), Email: String("a@example.com")},
+		2: {Name: String("b"), Email: String("b@example.com")},
+	}
+	got, err := client.Migrations.MapCommitAuthors(context.Background(), "o", "r", authors)
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("MapCommitAuthors returned error %v, want *BatchError", err)
+	}
+	if len(batchErr.Errors) != 1 || batchErr.Errors["2"] == nil {
+		t.Errorf("MapCommitAuthors errors = %v, want a failure for author 2", batchErr.Errors)
+	}
+
+	want := map[int64]*SourceImportAuthor{
+		1: {ID: Int64(1), Name: String("a"), Email: String("a@example.com")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapCommitAuthors = %+v, want %+v", got, want)
+	}
+}
+
 func TestMigrationService_SetLFSPreference(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -216,3 +252,70 @@ func TestMigrationService_CancelImport(t *testing.T) {
 		t.Errorf("CancelImport returned error: %v", err)
 	}
 }
+
+func TestMigrationService_WaitForImport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	statuses := []string{"detecting", "importing", "mapping", "pushing", "complete"}
+	calls := 0
+	mux.HandleFunc("/repos/o/r/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if calls >= len(statuses) {
+			t.Fatalf("unexpected request after status %q", statuses[len(statuses)-1])
+		}
+		fmt.Fprintf(w, `{"status":%q,"commit_count":%d}`, statuses[calls], calls)
+		calls++
+	})
+
+	got, _, err := client.Migrations.WaitForImport(context.Background(), "o", "r", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForImport returned error: %v", err)
+	}
+	want := &Import{Status: String("complete"), CommitCount: Int(4)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WaitForImport = %+v, want %+v", got, want)
+	}
+	if calls != len(statuses) {
+		t.Errorf("WaitForImport made %v requests, want %v", calls, len(statuses))
+	}
+}
+
+func TestMigrationService_WaitForImport_needsAttention(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	statuses := []string{"detecting", "detection_needs_auth"}
+	calls := 0
+	mux.HandleFunc("/repos/o/r/import", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status":%q}`, statuses[calls])
+		calls++
+	})
+
+	got, _, err := client.Migrations.WaitForImport(context.Background(), "o", "r", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForImport returned error: %v", err)
+	}
+	if got.GetStatus() != "detection_needs_auth" {
+		t.Errorf("WaitForImport status = %q, want %q", got.GetStatus(), "detection_needs_auth")
+	}
+}
+
+func TestMigrationService_WaitForImport_contextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/import", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"importing"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	got, _, err := client.Migrations.WaitForImport(ctx, "o", "r", time.Hour)
+	if err != context.DeadlineExceeded {
+		t.Errorf("WaitForImport returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got.GetStatus() != "importing" {
+		t.Errorf("WaitForImport status = %q, want %q", got.GetStatus(), "importing")
+	}
+}
@@ -39,6 +39,30 @@ func TestMigrationService_StartMigration(t *testing.T) {
 	}
 }
 
+func TestMigrationService_StartMigration_excludeOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"lock_repositories":false,"exclude_attachments":true,"exclude_releases":true,"exclude_owner_projects":false,"exclude_metadata":false,"exclude_git_data":false,"org_metadata_only":true,"exclude":["repositories"]}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(migrationJSON)
+	})
+
+	opt := &MigrationOptions{
+		ExcludeAttachments: true,
+		ExcludeReleases:    true,
+		OrgMetadataOnly:    true,
+		Exclude:            []string{"repositories"},
+	}
+	_, _, err := client.Migrations.StartMigration(context.Background(), "o", nil, opt)
+	if err != nil {
+		t.Errorf("StartMigration returned error: %v", err)
+	}
+}
+
 func TestMigrationService_ListMigrations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -139,6 +163,32 @@ func TestMigrationService_UnlockRepo(t *testing.T) {
 	}
 }
 
+func TestMigrationService_MigrationStatus_transitions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	states := []string{"pending", "exporting", "exported"}
+	calls := 0
+	mux.HandleFunc("/orgs/o/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":1,"state":%q,"exclude_releases":true}`, states[calls])
+		calls++
+	})
+
+	for _, want := range states {
+		m, _, err := client.Migrations.MigrationStatus(context.Background(), "o", 1)
+		if err != nil {
+			t.Fatalf("MigrationStatus returned error: %v", err)
+		}
+		if got := m.GetState(); got != want {
+			t.Errorf("MigrationStatus returned state %q, want %q", got, want)
+		}
+		if !m.GetExcludeReleases() {
+			t.Errorf("MigrationStatus returned ExcludeReleases false, want true")
+		}
+	}
+}
+
 var migrationJSON = []byte(`{
   "id": 79,
   "guid": "0b989ba4-242f-11e5-81e1-c7b6966d2516",
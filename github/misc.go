@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 )
 
 // MarkdownOptions specifies optional parameters to the Markdown method.
@@ -184,6 +186,53 @@ func (c *Client) APIMeta(ctx context.Context) (*APIMeta, *Response, error) {
 	return meta, resp, nil
 }
 
+// ServerVersionCloud is the version Client.ServerVersion reports for
+// GitHub.com, which isn't versioned.
+const ServerVersionCloud = "cloud"
+
+// ServerVersion returns the version of the GitHub Enterprise Server instance
+// c talks to, such as "3.9.0", as reported by its X-GitHub-Enterprise-Version
+// response header, or ServerVersionCloud for GitHub.com, whose responses don't
+// carry that header. It makes an APIMeta request. Use ServerVersionAtLeast to
+// gate features on the version.
+func (c *Client) ServerVersion(ctx context.Context) (string, *Response, error) {
+	_, resp, err := c.APIMeta(ctx)
+	if err != nil {
+		return "", resp, err
+	}
+
+	if v := strings.TrimSpace(resp.Header.Get(headerEnterpriseVersion)); v != "" {
+		return v, resp, nil
+	}
+	return ServerVersionCloud, resp, nil
+}
+
+// ServerVersionAtLeast reports whether version, as returned by
+// Client.ServerVersion, is major.minor or later. ServerVersionCloud is later
+// than any GitHub Enterprise Server version, since features reach
+// GitHub.com first. version is parsed as a semantic version, such as
+// "3.9.2" or "3.10.0-rc.1", and may leave out the patch number. Pre-releases
+// of major.minor.0 count as major.minor. A version that can't be parsed is
+// reported as too old.
+func ServerVersionAtLeast(version string, major, minor int) bool {
+	if version == ServerVersionCloud {
+		return true
+	}
+	v, ok := parseSemver(version)
+	if !ok {
+		if v, ok = parseSemver(version + ".0"); !ok {
+			return false
+		}
+	}
+	if major < 0 || minor < 0 {
+		return true
+	}
+	// 0 is the lowest pre-release identifier, so that any pre-release of
+	// major.minor.0 is at least min.
+	min := &semver{major: uint64(major), minor: uint64(minor), pre: []string{"0"}}
+	return !v.less(min)
+}
+
 // Octocat returns an ASCII art octocat with the specified message in a speech
 // bubble. If message is empty, a random zen phrase is used.
 func (c *Client) Octocat(ctx context.Context, message string) (string, *Response, error) {
@@ -255,3 +304,92 @@ func (c *Client) ListServiceHooks(ctx context.Context) ([]*ServiceHook, *Respons
 
 	return hooks, resp, nil
 }
+
+// TokenType is the kind of credentials a client authenticates with, as
+// determined by Client.WhoAmI.
+type TokenType string
+
+// The token types reported by Client.WhoAmI.
+const (
+	// TokenTypeUser is a token acting as a user: a personal access token,
+	// an OAuth app token, or a GitHub App user access token.
+	TokenTypeUser TokenType = "user"
+	// TokenTypeInstallation is a GitHub App installation access token.
+	TokenTypeInstallation TokenType = "installation"
+	// TokenTypeApp is a JSON Web Token authenticating as a GitHub App.
+	TokenTypeApp TokenType = "app"
+)
+
+// Identity describes who a client is authenticated as. It is returned by
+// Client.WhoAmI.
+type Identity struct {
+	TokenType TokenType
+
+	// User is the authenticated user. It is only set for TokenTypeUser.
+	User *User
+	// App is the authenticated GitHub App. It is only set for TokenTypeApp.
+	App *App
+
+	// Scopes lists the OAuth scopes granted to the token, as reported in
+	// the X-OAuth-Scopes header. It is nil when GitHub does not report
+	// scopes, as for fine-grained personal access tokens and GitHub App
+	// tokens, whose permissions are not expressed as scopes.
+	Scopes []Scope
+}
+
+// Login returns the login of the authenticated user, or the slug of the
+// authenticated GitHub App. It is empty for installation tokens, which
+// cannot look up the app they belong to.
+func (i *Identity) Login() string {
+	switch {
+	case i.User != nil:
+		return i.User.GetLogin()
+	case i.App != nil:
+		return i.App.GetSlug()
+	}
+	return ""
+}
+
+// WhoAmI determines what kind of credentials c authenticates with and who
+// they belong to.
+//
+// It first fetches the authenticated user. GitHub Apps cannot do that and
+// are refused with a 401 or 403 status, in which case WhoAmI lists the
+// installation's repositories to detect an installation access token, and
+// then fetches the authenticated app to detect an app JSON Web Token. If
+// none of these requests succeed, the error of the first one is returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#get-the-authenticated-user
+func (c *Client) WhoAmI(ctx context.Context) (*Identity, *Response, error) {
+	user, resp, err := c.Users.Get(ctx, "")
+	if err == nil {
+		id := &Identity{TokenType: TokenTypeUser, User: user}
+		if _, ok := resp.Header[http.CanonicalHeaderKey(headerOAuthScopes)]; ok {
+			id.Scopes = parseScopes(resp.Header.Get(headerOAuthScopes))
+		}
+		return id, resp, nil
+	}
+	if resp == nil || (resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden) {
+		return nil, resp, err
+	}
+
+	if _, installResp, installErr := c.Apps.ListRepos(ctx, &ListOptions{PerPage: 1}); installErr == nil {
+		return &Identity{TokenType: TokenTypeInstallation}, installResp, nil
+	}
+	if app, appResp, appErr := c.Apps.Get(ctx, ""); appErr == nil {
+		return &Identity{TokenType: TokenTypeApp, App: app}, appResp, nil
+	}
+	return nil, resp, err
+}
+
+// parseScopes parses the comma-separated list of scopes in an
+// X-OAuth-Scopes header.
+func parseScopes(header string) []Scope {
+	scopes := []Scope{}
+	for _, s := range strings.Split(header, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			scopes = append(scopes, Scope(s))
+		}
+	}
+	return scopes
+}
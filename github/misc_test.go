@@ -175,6 +175,70 @@ func TestAPIMeta(t *testing.T) {
 	}
 }
 
+func TestServerVersion_enterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("X-GitHub-Enterprise-Version", "3.9.2")
+		fmt.Fprint(w, `{}`)
+	})
+
+	version, _, err := client.ServerVersion(context.Background())
+	if err != nil {
+		t.Errorf("ServerVersion returned error: %v", err)
+	}
+	if want := "3.9.2"; version != want {
+		t.Errorf("ServerVersion returned %q, want %q", version, want)
+	}
+}
+
+func TestServerVersion_cloud(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	version, _, err := client.ServerVersion(context.Background())
+	if err != nil {
+		t.Errorf("ServerVersion returned error: %v", err)
+	}
+	if version != ServerVersionCloud {
+		t.Errorf("ServerVersion returned %q, want %q", version, ServerVersionCloud)
+	}
+}
+
+func TestServerVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version      string
+		major, minor int
+		want         bool
+	}{
+		{ServerVersionCloud, 99, 0, true},
+		{"3.9.2", 3, 9, true},
+		{"3.9.2", 3, 10, false},
+		{"3.10.0", 3, 9, true},
+		{"4.0.0", 3, 12, true},
+		{"2.22.5", 3, 0, false},
+		{"3.9", 3, 9, true},
+		{"3.10.0-rc.1", 3, 10, true},
+		{"3.10.0-rc.1", 3, 11, false},
+		{"3.9.17+build.5", 3, 9, true},
+		{"v3.9.1", 3, 9, true},
+		{"3", 3, 0, false},
+		{"3.9.x", 3, 0, false},
+		{"garbage", 0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := ServerVersionAtLeast(tt.version, tt.major, tt.minor); got != tt.want {
+			t.Errorf("ServerVersionAtLeast(%q, %v, %v) = %v, want %v", tt.version, tt.major, tt.minor, got, tt.want)
+		}
+	}
+}
+
 func TestOctocat(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -252,3 +316,123 @@ func TestListServiceHooks(t *testing.T) {
 		t.Errorf("ListServiceHooks returned %+v, want %+v", hooks, want)
 	}
 }
+
+func TestWhoAmI_user(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("X-OAuth-Scopes", "repo, read:org")
+		fmt.Fprint(w, `{"login":"l"}`)
+	})
+
+	id, _, err := client.WhoAmI(context.Background())
+	if err != nil {
+		t.Fatalf("WhoAmI returned error: %v", err)
+	}
+
+	want := &Identity{
+		TokenType: TokenTypeUser,
+		User:      &User{Login: String("l")},
+		Scopes:    []Scope{ScopeRepo, ScopeReadOrg},
+	}
+	if !reflect.DeepEqual(id, want) {
+		t.Errorf("WhoAmI returned %+v, want %+v", id, want)
+	}
+	if got, want := id.Login(), "l"; got != want {
+		t.Errorf("Identity.Login returned %q, want %q", got, want)
+	}
+}
+
+func TestWhoAmI_fineGrainedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"login":"l"}`)
+	})
+
+	id, _, err := client.WhoAmI(context.Background())
+	if err != nil {
+		t.Fatalf("WhoAmI returned error: %v", err)
+	}
+	if id.TokenType != TokenTypeUser || id.Scopes != nil {
+		t.Errorf("WhoAmI returned %+v, want a user token without scopes", id)
+	}
+}
+
+func TestWhoAmI_installation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"Resource not accessible by integration"}`)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{"total_count":1,"repositories":[{"id":1}]}`)
+	})
+
+	id, _, err := client.WhoAmI(context.Background())
+	if err != nil {
+		t.Fatalf("WhoAmI returned error: %v", err)
+	}
+	if want := (&Identity{TokenType: TokenTypeInstallation}); !reflect.DeepEqual(id, want) {
+		t.Errorf("WhoAmI returned %+v, want %+v", id, want)
+	}
+}
+
+func TestWhoAmI_app(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"'Expiration time' claim ('exp') must be a numeric value representing the future time at which the assertion expires"}`)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	mux.HandleFunc("/app", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"slug":"my-app"}`)
+	})
+
+	id, _, err := client.WhoAmI(context.Background())
+	if err != nil {
+		t.Fatalf("WhoAmI returned error: %v", err)
+	}
+	if id.TokenType != TokenTypeApp {
+		t.Errorf("WhoAmI returned token type %q, want %q", id.TokenType, TokenTypeApp)
+	}
+	if got, want := id.Login(), "my-app"; got != want {
+		t.Errorf("Identity.Login returned %q, want %q", got, want)
+	}
+}
+
+func TestWhoAmI_unauthenticated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	for _, path := range []string{"/user", "/installation/repositories", "/app"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintf(w, `{"message":"Requires authentication %v"}`, r.URL.Path)
+		})
+	}
+
+	_, resp, err := client.WhoAmI(context.Background())
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("WhoAmI returned error %#v, want *ErrorResponse", err)
+	}
+	if got, want := errResp.Message, "Requires authentication /user"; got != want {
+		t.Errorf("WhoAmI returned error message %q, want %q", got, want)
+	}
+	if got, want := resp.StatusCode, http.StatusUnauthorized; got != want {
+		t.Errorf("WhoAmI returned status %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseNodeID returns the object type and numeric ID encoded in a GraphQL
+// node ID, such as the NodeID field of REST objects. For example, the node ID
+// "MDEwOlJlcG9zaXRvcnkxMjk2MjY5" decodes to the type "Repository" and the ID
+// 1296269, the same as the ID field of the repository.
+//
+// Only the legacy node ID format is supported: the base64 encoding of
+// "0<length of type>:<type><ID>". GitHub documents node IDs as opaque and
+// issues objects created more recently IDs in a newer format (such as
+// "R_kgDOABCDEF"), which isn't decodable and is reported as an error, as are
+// legacy IDs that don't end with a numeric ID (such as those of refs). Code
+// that needs to handle every object should fetch it through the API rather
+// than rely on ParseNodeID.
+func ParseNodeID(nodeID string) (objectType string, dbID int64, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(nodeID)
+	if err != nil {
+		return "", 0, fmt.Errorf("node ID %q is not in the legacy format: %w", nodeID, err)
+	}
+
+	s := string(decoded)
+	i := strings.Index(s, ":")
+	if i < 2 || s[0] != '0' {
+		return "", 0, fmt.Errorf("node ID %q is not in the legacy format", nodeID)
+	}
+	n, err := strconv.Atoi(s[1:i])
+	if err != nil || n <= 0 || len(s) <= i+1+n {
+		return "", 0, fmt.Errorf("node ID %q is not in the legacy format", nodeID)
+	}
+
+	objectType = s[i+1 : i+1+n]
+	dbID, err = strconv.ParseInt(s[i+1+n:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("node ID %q of type %v has no numeric ID", nodeID, objectType)
+	}
+	return objectType, dbID, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "testing"
+
+func TestParseNodeID(t *testing.T) {
+	tests := []struct {
+		nodeID   string
+		wantType string
+		wantID   int64
+	}{
+		{"MDU6SXNzdWUxMzQ3", "Issue", 1347},
+		{"MDExOlB1bGxSZXF1ZXN0MQ==", "PullRequest", 1},
+		{"MDEwOlJlcG9zaXRvcnkxMjk2MjY5", "Repository", 1296269},
+	}
+
+	for _, tt := range tests {
+		gotType, gotID, err := ParseNodeID(tt.nodeID)
+		if err != nil {
+			t.Errorf("ParseNodeID(%q) returned error: %v", tt.nodeID, err)
+			continue
+		}
+		if gotType != tt.wantType || gotID != tt.wantID {
+			t.Errorf("ParseNodeID(%q) = %q, %v, want %q, %v", tt.nodeID, gotType, gotID, tt.wantType, tt.wantID)
+		}
+	}
+}
+
+func TestParseNodeID_invalid(t *testing.T) {
+	for _, nodeID := range []string{
+		"",
+		"R_kgDOABCDEF",                 // new format
+		"MDM6UmVmMTI5NjI2OTptYXN0ZXI=", // 03:Ref1296269:master
+		"MDU6SXNzdWU=",                 // 05:Issue, no ID
+		"MTA6UmVwb3NpdG9yeTE=",         // 10:Repository1
+	} {
+		if typ, id, err := ParseNodeID(nodeID); err == nil {
+			t.Errorf("ParseNodeID(%q) = %q, %v, want an error", nodeID, typ, id)
+		}
+	}
+}
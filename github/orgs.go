@@ -50,14 +50,16 @@ type Organization struct {
 	HasOrganizationProjects     *bool      `json:"has_organization_projects,omitempty"`
 	HasRepositoryProjects       *bool      `json:"has_repository_projects,omitempty"`
 
-	// DefaultRepoPermission can be one of: "read", "write", "admin", or "none". (Default: "read").
-	// It is only used in OrganizationsService.Edit.
+	// DefaultRepoPermission is the permission that members have on the
+	// organization's repositories. It can be one of: "read", "write",
+	// "admin", or "none". (Default: "read").
 	DefaultRepoPermission *string `json:"default_repository_permission,omitempty"`
 	// DefaultRepoSettings can be one of: "read", "write", "admin", or "none". (Default: "read").
 	// It is only used in OrganizationsService.Get.
 	DefaultRepoSettings *string `json:"default_repository_settings,omitempty"`
 
-	// MembersCanCreateRepos default value is true and is only used in Organizations.Edit.
+	// MembersCanCreateRepos reports whether members can create repositories.
+	// Its default value is true.
 	MembersCanCreateRepos *bool `json:"members_can_create_repositories,omitempty"`
 
 	// https://developer.github.com/changes/2019-12-03-internal-visibility-changes/#rest-v3-api
@@ -65,6 +67,10 @@ type Organization struct {
 	MembersCanCreatePrivateRepos  *bool `json:"members_can_create_private_repositories,omitempty"`
 	MembersCanCreateInternalRepos *bool `json:"members_can_create_internal_repositories,omitempty"`
 
+	// MembersCanForkPrivateRepos reports whether members can fork private
+	// repositories of the organization.
+	MembersCanForkPrivateRepos *bool `json:"members_can_fork_private_repositories,omitempty"`
+
 	// MembersAllowedRepositoryCreationType denotes if organization members can create repositories
 	// and the type of repositories they can create. Possible values are: "all", "private", or "none".
 	//
@@ -116,6 +122,7 @@ type OrganizationsListOptions struct {
 	// Note: Pagination is powered exclusively by the Since parameter,
 	// ListOptions.Page has no effect.
 	// ListOptions.PerPage controls an undocumented GitHub API parameter.
+	// Use ListAllFunc to paginate through all organizations.
 	ListOptions
 }
 
@@ -145,6 +152,29 @@ func (s *OrganizationsService) ListAll(ctx context.Context, opts *OrganizationsL
 	return orgs, resp, nil
 }
 
+// ListAllFunc lists all organizations, calling fn with each page of
+// organizations in the order they were created on GitHub. Each page is
+// requested with the ID of the last organization seen, starting after
+// opts.Since. Iteration stops when there are no more organizations, or when
+// fn returns an error, which is then returned.
+func (s *OrganizationsService) ListAllFunc(ctx context.Context, opts *ListSinceOptions, fn func([]*Organization) error) (*Response, error) {
+	o := new(ListSinceOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	return paginateSince(s.client.maxPages, o, func() (int64, *Response, error) {
+		orgs, resp, err := s.ListAll(ctx, &OrganizationsListOptions{Since: o.Since, ListOptions: ListOptions{PerPage: o.PerPage}})
+		if err != nil || len(orgs) == 0 {
+			return 0, resp, err
+		}
+		if err := fn(orgs); err != nil {
+			return 0, resp, err
+		}
+		return orgs[len(orgs)-1].GetID(), resp, nil
+	})
+}
+
 // List the organizations for a user. Passing the empty string will list
 // organizations for the authenticated user.
 //
@@ -239,6 +269,66 @@ func (s *OrganizationsService) Edit(ctx context.Context, name string, org *Organ
 	return o, resp, nil
 }
 
+// OrgRepositoryDefaultSettings holds the settings of an organization that
+// govern the repositories its members can create and their default access to
+// repositories. See the fields of the same names in Organization.
+type OrgRepositoryDefaultSettings struct {
+	DefaultRepoPermission         *string `json:"default_repository_permission,omitempty"`
+	MembersCanCreateRepos         *bool   `json:"members_can_create_repositories,omitempty"`
+	MembersCanCreatePublicRepos   *bool   `json:"members_can_create_public_repositories,omitempty"`
+	MembersCanCreatePrivateRepos  *bool   `json:"members_can_create_private_repositories,omitempty"`
+	MembersCanCreateInternalRepos *bool   `json:"members_can_create_internal_repositories,omitempty"`
+	MembersCanForkPrivateRepos    *bool   `json:"members_can_fork_private_repositories,omitempty"`
+}
+
+// GetRepositoryDefaultSettings fetches the repository default settings of an
+// organization. The authenticated user must be an owner of the organization
+// for the settings to be returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-an-organization
+func (s *OrganizationsService) GetRepositoryDefaultSettings(ctx context.Context, org string) (*OrgRepositoryDefaultSettings, *Response, error) {
+	u := fmt.Sprintf("orgs/%v", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeMemberAllowedRepoCreationTypePreview)
+
+	settings := new(OrgRepositoryDefaultSettings)
+	resp, err := s.client.Do(ctx, req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// EditRepositoryDefaultSettings updates the repository default settings of
+// an organization. Only the non-nil fields of settings are changed. The
+// resulting settings are returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#update-an-organization
+func (s *OrganizationsService) EditRepositoryDefaultSettings(ctx context.Context, org string, settings *OrgRepositoryDefaultSettings) (*OrgRepositoryDefaultSettings, *Response, error) {
+	u := fmt.Sprintf("orgs/%v", org)
+	req, err := s.client.NewRequest("PATCH", u, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeMemberAllowedRepoCreationTypePreview)
+
+	result := new(OrgRepositoryDefaultSettings)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
 // ListInstallations lists installations for an organization.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-app-installations-for-an-organization
@@ -0,0 +1,56 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GetOrgOIDCSubjectClaim gets the customization template for the OpenID
+// Connect subject claim of an organization, which is the default template of
+// its repositories.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/oidc#get-the-customization-template-for-an-oidc-subject-claim-for-an-organization
+func (s *OrganizationsService) GetOrgOIDCSubjectClaim(ctx context.Context, org string) (*OIDCSubjectClaimCustomTemplate, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/oidc/customization/sub", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tmpl := new(OIDCSubjectClaimCustomTemplate)
+	resp, err := s.client.Do(ctx, req, tmpl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tmpl, resp, nil
+}
+
+// SetOrgOIDCSubjectClaim sets the customization template for the OpenID
+// Connect subject claim of an organization. Only the IncludeClaimKeys field
+// of template is used; an empty list is sent as such.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/oidc#set-the-customization-template-for-an-oidc-subject-claim-for-an-organization
+func (s *OrganizationsService) SetOrgOIDCSubjectClaim(ctx context.Context, org string, template *OIDCSubjectClaimCustomTemplate) (*Response, error) {
+	if template == nil {
+		return nil, errors.New("template must be provided")
+	}
+
+	u := fmt.Sprintf("orgs/%v/actions/oidc/customization/sub", org)
+	body := &OIDCSubjectClaimCustomTemplate{IncludeClaimKeys: template.IncludeClaimKeys}
+	if body.IncludeClaimKeys == nil {
+		body.IncludeClaimKeys = []string{}
+	}
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
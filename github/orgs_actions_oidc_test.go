@@ -0,0 +1,85 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_GetOrgOIDCSubjectClaim(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/oidc/customization/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"include_claim_keys":["repo","context"]}`)
+	})
+
+	tmpl, _, err := client.Organizations.GetOrgOIDCSubjectClaim(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Organizations.GetOrgOIDCSubjectClaim returned error: %v", err)
+	}
+
+	want := &OIDCSubjectClaimCustomTemplate{IncludeClaimKeys: []string{"repo", "context"}}
+	if !reflect.DeepEqual(tmpl, want) {
+		t.Errorf("Organizations.GetOrgOIDCSubjectClaim returned %+v, want %+v", tmpl, want)
+	}
+}
+
+func TestOrganizationsService_GetOrgOIDCSubjectClaim_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Organizations.GetOrgOIDCSubjectClaim(context.Background(), "%")
+	testURLParseError(t, err)
+}
+
+func TestOrganizationsService_SetOrgOIDCSubjectClaim(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/oidc/customization/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"include_claim_keys":["repo","context"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &OIDCSubjectClaimCustomTemplate{UseDefault: Bool(true), IncludeClaimKeys: []string{"repo", "context"}}
+	_, err := client.Organizations.SetOrgOIDCSubjectClaim(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Organizations.SetOrgOIDCSubjectClaim returned error: %v", err)
+	}
+}
+
+func TestOrganizationsService_SetOrgOIDCSubjectClaim_empty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/oidc/customization/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"include_claim_keys":[]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Organizations.SetOrgOIDCSubjectClaim(context.Background(), "o", &OIDCSubjectClaimCustomTemplate{})
+	if err != nil {
+		t.Errorf("Organizations.SetOrgOIDCSubjectClaim returned error: %v", err)
+	}
+}
+
+func TestOrganizationsService_SetOrgOIDCSubjectClaim_nilTemplate(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if _, err := client.Organizations.SetOrgOIDCSubjectClaim(context.Background(), "o", nil); err == nil {
+		t.Error("Organizations.SetOrgOIDCSubjectClaim returned no error for a nil template")
+	}
+}
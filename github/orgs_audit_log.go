@@ -0,0 +1,173 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GetAuditLogOptions specifies the optional parameters to the
+// OrganizationsService.GetAuditLog method.
+type GetAuditLogOptions struct {
+	// Phrase is a search phrase filtering the events, such as
+	// "action:repo.create actor:octocat".
+	Phrase string `url:"phrase,omitempty"`
+
+	// Include is the event types to include. Possible values are: web, git,
+	// all. Default is "web".
+	Include string `url:"include,omitempty"`
+
+	// Order is the order of the events by timestamp. Possible values are:
+	// asc, desc. Default is "desc".
+	Order string `url:"order,omitempty"`
+
+	ListCursorOptions
+}
+
+// AuditEntry describes a single event in the audit log of an organization.
+type AuditEntry struct {
+	// Timestamp is the time of the event, in milliseconds since the Unix
+	// epoch.
+	Timestamp  *int64  `json:"@timestamp,omitempty"`
+	DocumentID *string `json:"_document_id,omitempty"`
+	Action     *string `json:"action,omitempty"`
+	Actor      *string `json:"actor,omitempty"`
+	ActorID    *int64  `json:"actor_id,omitempty"`
+	Business   *string `json:"business,omitempty"`
+	// CreatedAt is the time the event was recorded, in milliseconds since
+	// the Unix epoch.
+	CreatedAt  *int64  `json:"created_at,omitempty"`
+	Org        *string `json:"org,omitempty"`
+	OrgID      *int64  `json:"org_id,omitempty"`
+	Repo       *string `json:"repo,omitempty"`
+	Team       *string `json:"team,omitempty"`
+	User       *string `json:"user,omitempty"`
+	UserID     *int64  `json:"user_id,omitempty"`
+	Visibility *string `json:"visibility,omitempty"`
+}
+
+func (a AuditEntry) String() string {
+	return Stringify(a)
+}
+
+// GetAuditLog gets a page of the audit log of an organization. The
+// authenticated user must be an owner of the organization.
+//
+// The audit log uses cursor pagination: the returned Response has After and
+// Before set to the cursors of the next and previous pages. To fetch the
+// next page, set opts.After to Response.After. Cursors can be persisted to
+// resume reading the audit log later.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/orgs#get-the-audit-log-for-an-organization
+func (s *OrganizationsService) GetAuditLog(ctx context.Context, org string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/audit-log", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []*AuditEntry
+	resp, err := s.client.Do(ctx, req, &entries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entries, resp, nil
+}
+
+// GetAuditLogFunc reads the audit log of an organization page by page,
+// starting after opts.After, and calls fn with the entries of each page and
+// the cursor following them. A caller exporting the audit log can persist
+// that cursor once it has handled the entries, and later resume from it by
+// setting opts.After, without missing or repeating entries. Exporters
+// following new events should set opts.Order to "asc".
+//
+// Iteration stops when there are no more pages, or when fn returns an
+// error, which is then returned. GitHub gives no cursor following the last
+// page, so fn is then given a cursor that only GetAuditLogFunc accepts: it
+// resumes from the last page, skipping the entries already passed to fn.
+func (s *OrganizationsService) GetAuditLogFunc(ctx context.Context, org string, opts *GetAuditLogOptions, fn func(entries []*AuditEntry, after string) error) (*Response, error) {
+	o := new(GetAuditLogOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var seen map[string]bool
+	if strings.HasPrefix(o.After, auditLogResumePrefix) {
+		r, err := decodeAuditLogResume(o.After)
+		if err != nil {
+			return nil, err
+		}
+		o.After = r.After
+		seen = make(map[string]bool, len(r.Seen))
+		for _, id := range r.Seen {
+			seen[id] = true
+		}
+	}
+
+	return paginateCursor(s.client.maxPages, &o.ListCursorOptions, func() (*Response, error) {
+		entries, resp, err := s.GetAuditLog(ctx, org, o)
+		if err != nil {
+			return resp, err
+		}
+
+		after := resp.After
+		if after == "" || after == o.After {
+			// This is the last page: resume from it, skipping its entries.
+			r := &auditLogResume{After: o.After, Seen: []string{}}
+			for _, e := range entries {
+				r.Seen = append(r.Seen, e.GetDocumentID())
+			}
+			after = r.encode()
+		}
+
+		fresh := entries[:0:0]
+		for _, e := range entries {
+			if !seen[e.GetDocumentID()] {
+				fresh = append(fresh, e)
+			}
+		}
+		return resp, fn(fresh, after)
+	})
+}
+
+// auditLogResumePrefix starts the cursors GetAuditLogFunc passes to fn for
+// the last page of the audit log.
+const auditLogResumePrefix = "resume:"
+
+// auditLogResume is the content of a cursor starting with
+// auditLogResumePrefix: the cursor the last page was fetched with, and the
+// DocumentIDs of the entries already read from it.
+type auditLogResume struct {
+	After string   `json:"after,omitempty"`
+	Seen  []string `json:"seen"`
+}
+
+func (r *auditLogResume) encode() string {
+	b, _ := json.Marshal(r)
+	return auditLogResumePrefix + base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeAuditLogResume(cursor string) (*auditLogResume, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(cursor, auditLogResumePrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit log cursor %q: %w", cursor, err)
+	}
+	r := new(auditLogResume)
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, fmt.Errorf("invalid audit log cursor %q: %w", cursor, err)
+	}
+	return r, nil
+}
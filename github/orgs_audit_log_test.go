@@ -0,0 +1,157 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_GetAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"phrase":   "action:repo.create",
+			"include":  "all",
+			"order":    "asc",
+			"per_page": "1",
+			"after":    "c1",
+		})
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?per_page=1&after=c2>; rel="next"`)
+		fmt.Fprint(w, `[{"@timestamp":1615077308538,"_document_id":"d1","action":"repo.create","actor":"octocat","org":"o","repo":"o/r","created_at":1615077308538}]`)
+	})
+
+	opts := &GetAuditLogOptions{
+		Phrase:            "action:repo.create",
+		Include:           "all",
+		Order:             "asc",
+		ListCursorOptions: ListCursorOptions{PerPage: 1, After: "c1"},
+	}
+	entries, resp, err := client.Organizations.GetAuditLog(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetAuditLog returned error: %v", err)
+	}
+
+	want := []*AuditEntry{{
+		Timestamp:  Int64(1615077308538),
+		DocumentID: String("d1"),
+		Action:     String("repo.create"),
+		Actor:      String("octocat"),
+		Org:        String("o"),
+		Repo:       String("o/r"),
+		CreatedAt:  Int64(1615077308538),
+	}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("Organizations.GetAuditLog returned %+v, want %+v", entries, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("Organizations.GetAuditLog returned After %q, want %q", got, want)
+	}
+}
+
+func TestOrganizationsService_GetAuditLog_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Organizations.GetAuditLog(context.Background(), "%", nil)
+	testURLParseError(t, err)
+}
+
+func TestOrganizationsService_GetAuditLogFunc_resume(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// Three pages of one entry each, chained by their after cursors. The
+	// last page gains an entry once d4 is logged.
+	logged := false
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		switch after := r.FormValue("after"); after {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c1>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d1"}]`)
+		case "c1":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d2"}]`)
+		case "c2":
+			if logged {
+				fmt.Fprint(w, `[{"_document_id":"d3"},{"_document_id":"d4"}]`)
+			} else {
+				fmt.Fprint(w, `[{"_document_id":"d3"}]`)
+			}
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	var exported []string
+	var cursor string
+	export := func(entries []*AuditEntry, after string) error {
+		for _, e := range entries {
+			exported = append(exported, e.GetDocumentID())
+		}
+		cursor = after
+		return nil
+	}
+	resume := func() {
+		opts := &GetAuditLogOptions{ListCursorOptions: ListCursorOptions{After: cursor}}
+		if _, err := client.Organizations.GetAuditLogFunc(context.Background(), "o", opts, export); err != nil {
+			t.Fatalf("Organizations.GetAuditLogFunc returned error: %v", err)
+		}
+	}
+
+	// Simulate the exporter stopping after the first page.
+	errStop := errors.New("stop")
+	_, err := client.Organizations.GetAuditLogFunc(context.Background(), "o", nil, func(entries []*AuditEntry, after string) error {
+		export(entries, after)
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("Organizations.GetAuditLogFunc returned error %v, want %v", err, errStop)
+	}
+	if cursor != "c1" {
+		t.Fatalf("persisted cursor = %q, want %q", cursor, "c1")
+	}
+
+	// Resume from the persisted cursor, up to the last page.
+	resume()
+	if want := []string{"d1", "d2", "d3"}; !reflect.DeepEqual(exported, want) {
+		t.Errorf("exported entries %v, want %v", exported, want)
+	}
+
+	// Resume from the last page: nothing is repeated.
+	resume()
+	if want := []string{"d1", "d2", "d3"}; !reflect.DeepEqual(exported, want) {
+		t.Errorf("exported entries %v, want %v", exported, want)
+	}
+
+	// Once a new entry is logged, only that entry is exported.
+	logged = true
+	resume()
+	if want := []string{"d1", "d2", "d3", "d4"}; !reflect.DeepEqual(exported, want) {
+		t.Errorf("exported entries %v, want %v", exported, want)
+	}
+}
+
+func TestOrganizationsService_GetAuditLogFunc_invalidCursor(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	opts := &GetAuditLogOptions{ListCursorOptions: ListCursorOptions{After: auditLogResumePrefix + "!"}}
+	_, err := client.Organizations.GetAuditLogFunc(context.Background(), "o", opts, func([]*AuditEntry, string) error {
+		t.Error("fn called for an invalid cursor")
+		return nil
+	})
+	if err == nil {
+		t.Error("Organizations.GetAuditLogFunc returned no error for an invalid cursor")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// UsageReportOptions specifies the optional parameters to the
+// OrganizationsService.GetUsageReport method. Each filter narrows the report
+// to a period of time.
+type UsageReportOptions struct {
+	// Year restricts the report to the given year, such as 2025.
+	Year *int `url:"year,omitempty"`
+
+	// Month restricts the report to the given month, from 1 to 12.
+	Month *int `url:"month,omitempty"`
+
+	// Day restricts the report to the given day of the month, from 1 to 31.
+	Day *int `url:"day,omitempty"`
+
+	// Hour restricts the report to the given hour of the day, from 0 to 23.
+	Hour *int `url:"hour,omitempty"`
+}
+
+// UsageItem represents the usage of a product, by one repository, during
+// a period of time in a UsageReport.
+type UsageItem struct {
+	Date             *string  `json:"date,omitempty"`
+	Product          *string  `json:"product,omitempty"`
+	SKU              *string  `json:"sku,omitempty"`
+	Quantity         *float64 `json:"quantity,omitempty"`
+	UnitType         *string  `json:"unitType,omitempty"`
+	PricePerUnit     *float64 `json:"pricePerUnit,omitempty"`
+	GrossAmount      *float64 `json:"grossAmount,omitempty"`
+	DiscountAmount   *float64 `json:"discountAmount,omitempty"`
+	NetAmount        *float64 `json:"netAmount,omitempty"`
+	OrganizationName *string  `json:"organizationName,omitempty"`
+	RepositoryName   *string  `json:"repositoryName,omitempty"`
+}
+
+func (u UsageItem) String() string {
+	return Stringify(u)
+}
+
+// UsageReport represents the billing usage report of an organization.
+type UsageReport struct {
+	UsageItems []*UsageItem `json:"usageItems,omitempty"`
+}
+
+// GetUsageReport gets the detailed billing usage report of an organization,
+// with one item per product, SKU, repository and day (or hour, if opts.Hour
+// is set). Only organization owners and billing managers can get the report.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/enhanced-billing#get-billing-usage-report-for-an-organization
+func (s *OrganizationsService) GetUsageReport(ctx context.Context, org string, opts *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("organizations/%v/settings/billing/usage", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(UsageReport)
+	resp, err := s.client.Do(ctx, req, report)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return report, resp, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_GetUsageReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/o/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"year": "2025", "month": "1", "hour": "0"})
+		fmt.Fprint(w, `{
+			"usageItems": [{
+				"date": "2025-01-01T00:00:00Z",
+				"product": "Actions",
+				"sku": "Actions Linux",
+				"quantity": 100,
+				"unitType": "minutes",
+				"pricePerUnit": 0.008,
+				"grossAmount": 0.8,
+				"discountAmount": 0.2,
+				"netAmount": 0.6,
+				"organizationName": "o",
+				"repositoryName": "o/r"
+			}]
+		}`)
+	})
+
+	opts := &UsageReportOptions{Year: Int(2025), Month: Int(1), Hour: Int(0)}
+	report, _, err := client.Organizations.GetUsageReport(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetUsageReport returned error: %v", err)
+	}
+
+	want := &UsageReport{
+		UsageItems: []*UsageItem{{
+			Date:             String("2025-01-01T00:00:00Z"),
+			Product:          String("Actions"),
+			SKU:              String("Actions Linux"),
+			Quantity:         Float64(100),
+			UnitType:         String("minutes"),
+			PricePerUnit:     Float64(0.008),
+			GrossAmount:      Float64(0.8),
+			DiscountAmount:   Float64(0.2),
+			NetAmount:        Float64(0.6),
+			OrganizationName: String("o"),
+			RepositoryName:   String("o/r"),
+		}},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("Organizations.GetUsageReport returned %+v, want %+v", report, want)
+	}
+}
+
+func TestOrganizationsService_GetUsageReport_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Organizations.GetUsageReport(context.Background(), "%", nil)
+	testURLParseError(t, err)
+}
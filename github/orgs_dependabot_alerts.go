@@ -0,0 +1,227 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependabotAlert represents a Dependabot alert.
+type DependabotAlert struct {
+	Number *int `json:"number,omitempty"`
+	// State is the state of the alert. Possible values are: auto_dismissed,
+	// dismissed, fixed, open.
+	State                 *string                     `json:"state,omitempty"`
+	Dependency            *Dependency                 `json:"dependency,omitempty"`
+	SecurityAdvisory      *DependabotSecurityAdvisory `json:"security_advisory,omitempty"`
+	SecurityVulnerability *AdvisoryVulnerability      `json:"security_vulnerability,omitempty"`
+	URL                   *string                     `json:"url,omitempty"`
+	HTMLURL               *string                     `json:"html_url,omitempty"`
+	CreatedAt             *Timestamp                  `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp                  `json:"updated_at,omitempty"`
+	DismissedAt           *Timestamp                  `json:"dismissed_at,omitempty"`
+	DismissedBy           *User                       `json:"dismissed_by,omitempty"`
+	DismissedReason       *string                     `json:"dismissed_reason,omitempty"`
+	DismissedComment      *string                     `json:"dismissed_comment,omitempty"`
+	FixedAt               *Timestamp                  `json:"fixed_at,omitempty"`
+	AutoDismissedAt       *Timestamp                  `json:"auto_dismissed_at,omitempty"`
+	// Repository is only populated when listing the alerts of an
+	// organization.
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+func (a DependabotAlert) String() string {
+	return Stringify(a)
+}
+
+// Dependency represents the vulnerable dependency of a DependabotAlert.
+type Dependency struct {
+	Package      *VulnerabilityPackage `json:"package,omitempty"`
+	ManifestPath *string               `json:"manifest_path,omitempty"`
+	// Scope is the execution scope of the dependency. Possible values are:
+	// development, runtime.
+	Scope *string `json:"scope,omitempty"`
+}
+
+// VulnerabilityPackage represents a package affected by a vulnerability.
+type VulnerabilityPackage struct {
+	// Ecosystem is the package ecosystem, such as npm, pip, maven or rubygems.
+	Ecosystem *string `json:"ecosystem,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// DependabotSecurityAdvisory represents the security advisory a
+// DependabotAlert was raised for.
+type DependabotSecurityAdvisory struct {
+	GHSAID          *string                  `json:"ghsa_id,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	Summary         *string                  `json:"summary,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	// Severity is the severity of the advisory. Possible values are: low,
+	// medium, high, critical.
+	Severity    *string               `json:"severity,omitempty"`
+	CVSS        *AdvisoryCVSS         `json:"cvss,omitempty"`
+	CWEs        []*AdvisoryCWE        `json:"cwes,omitempty"`
+	Identifiers []*AdvisoryIdentifier `json:"identifiers,omitempty"`
+	References  []*AdvisoryReference  `json:"references,omitempty"`
+	PublishedAt *Timestamp            `json:"published_at,omitempty"`
+	UpdatedAt   *Timestamp            `json:"updated_at,omitempty"`
+	WithdrawnAt *Timestamp            `json:"withdrawn_at,omitempty"`
+}
+
+// AdvisoryVulnerability represents the versions of a package a security
+// advisory applies to.
+type AdvisoryVulnerability struct {
+	Package *VulnerabilityPackage `json:"package,omitempty"`
+	// Severity is the severity of the vulnerability. Possible values are:
+	// low, medium, high, critical.
+	Severity               *string              `json:"severity,omitempty"`
+	VulnerableVersionRange *string              `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *FirstPatchedVersion `json:"first_patched_version,omitempty"`
+}
+
+// FirstPatchedVersion identifies the first version of a package that is not
+// affected by a vulnerability.
+type FirstPatchedVersion struct {
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// AdvisoryCVSS represents the CVSS score of a security advisory.
+type AdvisoryCVSS struct {
+	Score        *float64 `json:"score,omitempty"`
+	VectorString *string  `json:"vector_string,omitempty"`
+}
+
+// AdvisoryCWE represents a weakness a security advisory is classified under.
+type AdvisoryCWE struct {
+	CWEID *string `json:"cwe_id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// AdvisoryIdentifier represents an identifier of a security advisory.
+type AdvisoryIdentifier struct {
+	// Type is the type of the identifier. Possible values are: CVE, GHSA.
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// AdvisoryReference represents a reference URL of a security advisory.
+type AdvisoryReference struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// ListDependabotAlertsOptions specifies the optional parameters to the
+// OrganizationsService.ListDependabotAlerts method. The filters accept
+// comma-separated lists of values.
+type ListDependabotAlertsOptions struct {
+	// State filters alerts by state. Possible values are: auto_dismissed,
+	// dismissed, fixed, open.
+	State string `url:"state,omitempty"`
+
+	// Severity filters alerts by severity. Possible values are: low,
+	// medium, high, critical.
+	Severity string `url:"severity,omitempty"`
+
+	// Ecosystem filters alerts by package ecosystem, such as npm or pip.
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Package filters alerts by package name.
+	Package string `url:"package,omitempty"`
+
+	// Scope filters alerts by the scope of the vulnerable dependency.
+	// Possible values are: development, runtime.
+	Scope string `url:"scope,omitempty"`
+
+	// Sort specifies how to sort alerts. Possible values are: created,
+	// updated. Default is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort alerts. Possible values are: asc, desc.
+	// Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
+}
+
+// ListDependabotAlerts lists the Dependabot alerts of the repositories of an
+// organization. The endpoint uses cursor pagination: set opts.After to
+// Response.After to fetch the next page, or use PaginateCursor.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#list-dependabot-alerts-for-an-organization
+func (s *OrganizationsService) ListDependabotAlerts(ctx context.Context, org string, opts *ListDependabotAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/dependabot/alerts", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*DependabotAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// DependabotAlertsSummary counts the Dependabot alerts of an organization,
+// as returned by OrganizationsService.GetDependabotAlertsSummary.
+type DependabotAlertsSummary struct {
+	Total int
+	// BySeverity counts alerts by the severity of their vulnerability, such
+	// as "critical" or "low".
+	BySeverity map[string]int
+	// ByEcosystem counts alerts by the ecosystem of their vulnerable
+	// package, such as "npm" or "pip".
+	ByEcosystem map[string]int
+}
+
+// GetDependabotAlertsSummary counts the Dependabot alerts of an organization
+// by severity and by package ecosystem. opts filters the alerts counted as it
+// does for ListDependabotAlerts; since alerts in every state are listed by
+// default, set opts.State to "open" to count only the alerts still to be
+// addressed. The severity of an alert is that of its vulnerability, or of
+// its advisory if the vulnerability has none.
+//
+// Every alert is listed to compute the summary, 100 per request unless
+// opts.PerPage says otherwise, so this costs one request per page of alerts
+// against the rate limit. If a request fails, the error is returned along
+// with the counts of the alerts listed so far.
+func (s *OrganizationsService) GetDependabotAlertsSummary(ctx context.Context, org string, opts *ListDependabotAlertsOptions) (*DependabotAlertsSummary, *Response, error) {
+	o := new(ListDependabotAlertsOptions)
+	if opts != nil {
+		*o = *opts
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+
+	summary := &DependabotAlertsSummary{
+		BySeverity:  make(map[string]int),
+		ByEcosystem: make(map[string]int),
+	}
+	resp, err := paginateCursor(s.client.maxPages, &o.ListCursorOptions, func() (*Response, error) {
+		alerts, resp, err := s.ListDependabotAlerts(ctx, org, o)
+		for _, a := range alerts {
+			severity := a.GetSecurityVulnerability().GetSeverity()
+			if severity == "" {
+				severity = a.GetSecurityAdvisory().GetSeverity()
+			}
+			summary.Total++
+			summary.BySeverity[severity]++
+			summary.ByEcosystem[a.GetDependency().GetPackage().GetEcosystem()]++
+		}
+		return resp, err
+	})
+	return summary, resp, err
+}
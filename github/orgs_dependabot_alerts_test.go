@@ -0,0 +1,115 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListDependabotAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "severity": "high,critical", "per_page": "2", "after": "c1"})
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/dependabot/alerts?per_page=2&after=c2>; rel="next"`)
+		fmt.Fprint(w, `[{
+			"number": 2,
+			"state": "open",
+			"dependency": {"package": {"ecosystem": "npm", "name": "lodash"}, "manifest_path": "package-lock.json", "scope": "runtime"},
+			"security_advisory": {"ghsa_id": "GHSA-1", "cve_id": "CVE-1", "severity": "high", "cvss": {"score": 7.5, "vector_string": "v"}},
+			"security_vulnerability": {"package": {"ecosystem": "npm", "name": "lodash"}, "severity": "high", "vulnerable_version_range": "< 4.17.21", "first_patched_version": {"identifier": "4.17.21"}},
+			"repository": {"id": 1, "full_name": "o/r"}
+		}]`)
+	})
+
+	opts := &ListDependabotAlertsOptions{
+		State:             "open",
+		Severity:          "high,critical",
+		ListCursorOptions: ListCursorOptions{PerPage: 2, After: "c1"},
+	}
+	alerts, resp, err := client.Organizations.ListDependabotAlerts(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListDependabotAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{{
+		Number: Int(2),
+		State:  String("open"),
+		Dependency: &Dependency{
+			Package:      &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("lodash")},
+			ManifestPath: String("package-lock.json"),
+			Scope:        String("runtime"),
+		},
+		SecurityAdvisory: &DependabotSecurityAdvisory{
+			GHSAID:   String("GHSA-1"),
+			CVEID:    String("CVE-1"),
+			Severity: String("high"),
+			CVSS:     &AdvisoryCVSS{Score: Float64(7.5), VectorString: String("v")},
+		},
+		SecurityVulnerability: &AdvisoryVulnerability{
+			Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("lodash")},
+			Severity:               String("high"),
+			VulnerableVersionRange: String("< 4.17.21"),
+			FirstPatchedVersion:    &FirstPatchedVersion{Identifier: String("4.17.21")},
+		},
+		Repository: &Repository{ID: Int64(1), FullName: String("o/r")},
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Organizations.ListDependabotAlerts returned %+v, want %+v", alerts, want)
+	}
+	if resp.After != "c2" {
+		t.Errorf("Organizations.ListDependabotAlerts returned After %q, want %q", resp.After, "c2")
+	}
+}
+
+func TestOrganizationsService_GetDependabotAlertsSummary(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch after := r.FormValue("after"); after {
+		case "":
+			testFormValues(t, r, values{"state": "open", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/dependabot/alerts?state=open&per_page=100&after=c1>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"dependency":{"package":{"ecosystem":"npm"}},"security_vulnerability":{"severity":"critical"}},
+				{"dependency":{"package":{"ecosystem":"pip"}},"security_vulnerability":{"severity":"low"}}
+			]`)
+		case "c1":
+			fmt.Fprint(w, `[
+				{"dependency":{"package":{"ecosystem":"npm"}},"security_vulnerability":{"severity":"critical"}},
+				{"dependency":{"package":{"ecosystem":"npm"}},"security_advisory":{"severity":"medium"}}
+			]`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	opts := &ListDependabotAlertsOptions{State: "open"}
+	summary, _, err := client.Organizations.GetDependabotAlertsSummary(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetDependabotAlertsSummary returned error: %v", err)
+	}
+
+	want := &DependabotAlertsSummary{
+		Total:       4,
+		BySeverity:  map[string]int{"critical": 2, "medium": 1, "low": 1},
+		ByEcosystem: map[string]int{"npm": 3, "pip": 1},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("Organizations.GetDependabotAlertsSummary returned %+v, want %+v", summary, want)
+	}
+	if opts.After != "" || opts.PerPage != 0 {
+		t.Errorf("Organizations.GetDependabotAlertsSummary modified opts to %+v", opts)
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
@@ -261,6 +262,37 @@ func (s *OrganizationsService) EditOrgMembership(ctx context.Context, user, org
 	return m, resp, nil
 }
 
+// SetMembersRole sets the role of each of the given users in the specified
+// organization to role, which must be "admin" or "member". As with
+// EditOrgMembership, users who are not yet members of the organization are
+// invited to it with that role. The returned map holds the resulting
+// membership of each user whose update succeeded, keyed by username.
+//
+// One EditOrgMembership request is made per user, with a bounded number of
+// requests in flight at once, so each user counts against the rate limit and
+// large batches may take a while. If any of the updates fail, the memberships
+// of the successful updates are returned along with a *BatchError, keyed by
+// username, describing the failures.
+func (s *OrganizationsService) SetMembersRole(ctx context.Context, org string, usernames []string, role string) (map[string]*Membership, error) {
+	memberships := make([]*Membership, len(usernames))
+	err := forEachConcurrently(ctx, usernames, func(i int) error {
+		if usernames[i] == "" {
+			return errors.New("empty username")
+		}
+		var err error
+		memberships[i], _, err = s.EditOrgMembership(ctx, usernames[i], org, &Membership{Role: String(role)})
+		return err
+	})
+
+	result := make(map[string]*Membership, len(usernames))
+	for i, user := range usernames {
+		if memberships[i] != nil {
+			result[user] = memberships[i]
+		}
+	}
+	return result, err
+}
+
 // RemoveOrgMembership removes user from the specified organization. If the
 // user has been invited to the organization, this will cancel their invitation.
 //
@@ -275,6 +307,83 @@ func (s *OrganizationsService) RemoveOrgMembership(ctx context.Context, user, or
 	return s.client.Do(ctx, req, nil)
 }
 
+// OwnershipTransferOptions specifies the optional parameters to the
+// OrganizationsService.TransferOwnership method.
+type OwnershipTransferOptions struct {
+	// RemovePreviousOwner removes the previous owner from the organization
+	// once the new owner is in place. By default, the previous owner is
+	// kept as a regular member.
+	RemovePreviousOwner bool
+}
+
+// OwnershipTransferPendingError is returned by TransferOwnership when the new
+// owner is not yet a member of the organization. They have been invited as an
+// owner, and must accept the invitation themselves before the transfer can
+// complete. Calling TransferOwnership again after they accept finishes it.
+type OwnershipTransferPendingError struct {
+	Org      string // organization being transferred
+	NewOwner string // user who must accept the invitation
+}
+
+func (e *OwnershipTransferPendingError) Error() string {
+	return fmt.Sprintf("%v must accept the invitation to become an owner of %v before ownership can be transferred", e.NewOwner, e.Org)
+}
+
+// TransferOwnership hands over ownership of an organization from one owner,
+// from, to another user, to. The REST API has no single call for this, so
+// TransferOwnership chains the membership calls it needs, checking each step
+// before moving on:
+//
+//  1. from must be an active owner of the organization.
+//  2. to is made an owner with EditOrgMembership. If to is not yet a member,
+//     this invites them, and an *OwnershipTransferPendingError is returned
+//     without changing the role of from. Accepting the invitation is a
+//     manual step that only to can take.
+//  3. to is checked to be an active owner.
+//  4. from is demoted to a regular member, or removed from the organization
+//     if opts.RemovePreviousOwner is set.
+//
+// The authenticated user must be an owner of the organization. It may be
+// from itself, in which case it loses owner access in the last step.
+func (s *OrganizationsService) TransferOwnership(ctx context.Context, org, from, to string, opts *OwnershipTransferOptions) (*Response, error) {
+	if from == "" || to == "" {
+		return nil, errors.New("previous and new owner must be set")
+	}
+	if from == to {
+		return nil, fmt.Errorf("%v cannot transfer ownership of %v to themselves", from, org)
+	}
+
+	m, resp, err := s.GetOrgMembership(ctx, from, org)
+	if err != nil {
+		return resp, err
+	}
+	if m.GetRole() != "admin" || m.GetState() != "active" {
+		return resp, fmt.Errorf("%v is not an active owner of %v", from, org)
+	}
+
+	m, resp, err = s.EditOrgMembership(ctx, to, org, &Membership{Role: String("admin")})
+	if err != nil {
+		return resp, err
+	}
+	if m.GetState() != "active" {
+		return resp, &OwnershipTransferPendingError{Org: org, NewOwner: to}
+	}
+
+	m, resp, err = s.GetOrgMembership(ctx, to, org)
+	if err != nil {
+		return resp, err
+	}
+	if m.GetRole() != "admin" || m.GetState() != "active" {
+		return resp, fmt.Errorf("%v did not become an active owner of %v", to, org)
+	}
+
+	if opts != nil && opts.RemovePreviousOwner {
+		return s.RemoveOrgMembership(ctx, from, org)
+	}
+	_, resp, err = s.EditOrgMembership(ctx, from, org, &Membership{Role: String("member")})
+	return resp, err
+}
+
 // ListPendingOrgInvitations returns a list of pending invitations.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-pending-organization-invitations
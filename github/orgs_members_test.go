@@ -531,3 +531,155 @@ func TestOrganizationsService_ListOrgInvitationTeams(t *testing.T) {
 		t.Errorf("Organizations.ListOrgInvitationTeams returned %+v, want %+v", invitations, want)
 	}
 }
+
+func TestOrganizationsService_SetMembersRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	for _, user := range []string{"u1", "u2"} {
+		user := user
+		mux.HandleFunc("/orgs/o/memberships/"+user, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			testBody(t, r, `{"role":"admin"}`+"\n")
+			fmt.Fprintf(w, `{"role":"admin","user":{"login":%q}}`, user)
+		})
+	}
+	mux.HandleFunc("/orgs/o/memberships/u3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"You must be an admin"}`)
+	})
+
+	got, err := client.Organizations.SetMembersRole(context.Background(), "o", []string{"u1", "u2", "u3"}, "admin")
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Organizations.SetMembersRole returned error %#v, want *BatchError", err)
+	}
+	if _, ok := batchErr.Errors["u3"]; !ok || len(batchErr.Errors) != 1 {
+		t.Errorf("BatchError.Errors is %v, want only u3", batchErr.Errors)
+	}
+
+	want := map[string]*Membership{
+		"u1": {Role: String("admin"), User: &User{Login: String("u1")}},
+		"u2": {Role: String("admin"), User: &User{Login: String("u2")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.SetMembersRole returned %+v, want %+v", got, want)
+	}
+}
+
+// fakeOrgMemberships serves the organization membership endpoints of org "o"
+// from an in-memory map of username to membership.
+func fakeOrgMemberships(t *testing.T, mux *http.ServeMux, members map[string]*Membership, pending map[string]bool) {
+	mux.HandleFunc("/orgs/o/memberships/", func(w http.ResponseWriter, r *http.Request) {
+		user := r.URL.Path[len("/orgs/o/memberships/"):]
+		switch r.Method {
+		case "GET":
+			m, ok := members[user]
+			if !ok {
+				http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(m)
+		case "PUT":
+			v := new(Membership)
+			json.NewDecoder(r.Body).Decode(v)
+			m, ok := members[user]
+			if !ok {
+				m = &Membership{State: String("active")}
+				if pending[user] {
+					m.State = String("pending")
+				}
+				members[user] = m
+			}
+			m.Role = v.Role
+			json.NewEncoder(w).Encode(m)
+		case "DELETE":
+			delete(members, user)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+}
+
+func TestOrganizationsService_TransferOwnership(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	members := map[string]*Membership{
+		"old": {Role: String("admin"), State: String("active")},
+		"new": {Role: String("member"), State: String("active")},
+	}
+	fakeOrgMemberships(t, mux, members, nil)
+
+	if _, err := client.Organizations.TransferOwnership(context.Background(), "o", "old", "new", nil); err != nil {
+		t.Fatalf("Organizations.TransferOwnership returned error: %v", err)
+	}
+
+	if got := members["new"].GetRole(); got != "admin" {
+		t.Errorf("new owner has role %q, want admin", got)
+	}
+	if got := members["old"].GetRole(); got != "member" {
+		t.Errorf("previous owner has role %q, want member", got)
+	}
+}
+
+func TestOrganizationsService_TransferOwnership_removePreviousOwner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	members := map[string]*Membership{
+		"old": {Role: String("admin"), State: String("active")},
+		"new": {Role: String("member"), State: String("active")},
+	}
+	fakeOrgMemberships(t, mux, members, nil)
+
+	opts := &OwnershipTransferOptions{RemovePreviousOwner: true}
+	if _, err := client.Organizations.TransferOwnership(context.Background(), "o", "old", "new", opts); err != nil {
+		t.Fatalf("Organizations.TransferOwnership returned error: %v", err)
+	}
+
+	if _, ok := members["old"]; ok {
+		t.Errorf("previous owner is still a member")
+	}
+}
+
+func TestOrganizationsService_TransferOwnership_pending(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	members := map[string]*Membership{
+		"old": {Role: String("admin"), State: String("active")},
+	}
+	fakeOrgMemberships(t, mux, members, map[string]bool{"new": true})
+
+	_, err := client.Organizations.TransferOwnership(context.Background(), "o", "old", "new", nil)
+	if _, ok := err.(*OwnershipTransferPendingError); !ok {
+		t.Fatalf("Organizations.TransferOwnership returned error %v, want *OwnershipTransferPendingError", err)
+	}
+	if got := members["old"].GetRole(); got != "admin" {
+		t.Errorf("previous owner has role %q, want admin to be kept", got)
+	}
+}
+
+func TestOrganizationsService_TransferOwnership_notOwner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	members := map[string]*Membership{
+		"old": {Role: String("member"), State: String("active")},
+		"new": {Role: String("member"), State: String("active")},
+	}
+	fakeOrgMemberships(t, mux, members, nil)
+
+	if _, err := client.Organizations.TransferOwnership(context.Background(), "o", "old", "new", nil); err == nil {
+		t.Fatal("Organizations.TransferOwnership returned no error, want error")
+	}
+	if got := members["new"].GetRole(); got != "member" {
+		t.Errorf("new owner has role %q, want member to be kept", got)
+	}
+
+	if _, err := client.Organizations.TransferOwnership(context.Background(), "o", "old", "old", nil); err == nil {
+		t.Error("Organizations.TransferOwnership to the same user returned no error, want error")
+	}
+}
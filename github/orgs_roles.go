@@ -0,0 +1,171 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomOrgRole represents a role that can be assigned to users and teams of
+// an organization, either predefined by GitHub or created by the
+// organization.
+type CustomOrgRole struct {
+	ID          *int64        `json:"id,omitempty"`
+	Name        *string       `json:"name,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	Permissions []string      `json:"permissions,omitempty"`
+	Org         *Organization `json:"organization,omitempty"`
+	CreatedAt   *Timestamp    `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp    `json:"updated_at,omitempty"`
+	// Source is the origin of the role. Possible values are:
+	// Organization, Enterprise, Predefined.
+	Source *string `json:"source,omitempty"`
+	// BaseRole is the system role the role inherits permissions from.
+	BaseRole *string `json:"base_role,omitempty"`
+}
+
+// OrganizationCustomRoles represents the roles of an organization.
+type OrganizationCustomRoles struct {
+	TotalCount  *int             `json:"total_count,omitempty"`
+	CustomRoles []*CustomOrgRole `json:"roles,omitempty"`
+}
+
+// ListRoles lists the roles available in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#get-all-organization-roles-for-an-organization
+func (s *OrganizationsService) ListRoles(ctx context.Context, org string) (*OrganizationCustomRoles, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := new(OrganizationCustomRoles)
+	resp, err := s.client.Do(ctx, req, roles)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return roles, resp, nil
+}
+
+// ListTeamsAssignedToOrgRole lists the teams assigned to an organization role.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#list-teams-that-are-assigned-to-an-organization-role
+func (s *OrganizationsService) ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *ListOptions) ([]*Team, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/%v/teams", org, roleID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var teams []*Team
+	resp, err := s.client.Do(ctx, req, &teams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return teams, resp, nil
+}
+
+// ListUsersAssignedToOrgRole lists the users assigned to an organization
+// role, directly or through one of their teams.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#list-users-that-are-assigned-to-an-organization-role
+func (s *OrganizationsService) ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *ListOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/%v/users", org, roleID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	resp, err := s.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// OrgRoleAssignments represents the users and teams assigned to an
+// organization role.
+type OrgRoleAssignments struct {
+	Role  *CustomOrgRole
+	Users []*User
+	Teams []*Team
+}
+
+// ListRoleAssignments lists every role of an organization along with all the
+// users and teams assigned to it, keyed by role name. Roles nobody is
+// assigned to are included with no users or teams.
+//
+// Besides listing the roles, all the pages of ListUsersAssignedToOrgRole and
+// ListTeamsAssignedToOrgRole are requested for each role, with a bounded
+// number of requests in flight at once, so this costs at least 1 + 2 ×
+// (number of roles) requests against the rate limit. If listing the
+// assignments of some roles fails, the assignments of the other roles are
+// returned along with a *BatchError keyed by role name.
+//
+// Repository roles are assigned per repository, and are not covered.
+func (s *OrganizationsService) ListRoleAssignments(ctx context.Context, org string) (map[string]*OrgRoleAssignments, *Response, error) {
+	roles, resp, err := s.ListRoles(ctx, org)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	assignments := make([]*OrgRoleAssignments, len(roles.CustomRoles))
+	names := make([]string, len(roles.CustomRoles))
+	for i, role := range roles.CustomRoles {
+		names[i] = role.GetName()
+	}
+	err = forEachConcurrently(ctx, names, func(i int) error {
+		role := roles.CustomRoles[i]
+		a := &OrgRoleAssignments{Role: role}
+
+		opts := &ListOptions{PerPage: 100}
+		_, err := paginate(s.client.maxPages, opts, func() (*Response, error) {
+			users, resp, err := s.ListUsersAssignedToOrgRole(ctx, org, role.GetID(), opts)
+			a.Users = append(a.Users, users...)
+			return resp, err
+		})
+		if err != nil {
+			return err
+		}
+
+		opts = &ListOptions{PerPage: 100}
+		_, err = paginate(s.client.maxPages, opts, func() (*Response, error) {
+			teams, resp, err := s.ListTeamsAssignedToOrgRole(ctx, org, role.GetID(), opts)
+			a.Teams = append(a.Teams, teams...)
+			return resp, err
+		})
+		if err != nil {
+			return err
+		}
+
+		assignments[i] = a
+		return nil
+	})
+
+	result := make(map[string]*OrgRoleAssignments, len(assignments))
+	for i, a := range assignments {
+		if a != nil {
+			result[names[i]] = a
+		}
+	}
+	return result, resp, err
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"roles":[{"id":1,"name":"security","permissions":["read_audit_logs"],"source":"Organization","created_at":`+referenceTimeStr+`}]}`)
+	})
+
+	roles, _, err := client.Organizations.ListRoles(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Organizations.ListRoles returned error: %v", err)
+	}
+
+	want := &OrganizationCustomRoles{
+		TotalCount: Int(1),
+		CustomRoles: []*CustomOrgRole{{
+			ID:          Int64(1),
+			Name:        String("security"),
+			Permissions: []string{"read_audit_logs"},
+			Source:      String("Organization"),
+			CreatedAt:   &Timestamp{referenceTime},
+		}},
+	}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("Organizations.ListRoles returned %+v, want %+v", roles, want)
+	}
+}
+
+func TestOrganizationsService_ListTeamsAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	teams, _, err := client.Organizations.ListTeamsAssignedToOrgRole(context.Background(), "o", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*Team{{ID: Int64(1)}}
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned %+v, want %+v", teams, want)
+	}
+}
+
+func TestOrganizationsService_ListUsersAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	users, _, err := client.Organizations.ListUsersAssignedToOrgRole(context.Background(), "o", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*User{{ID: Int64(1)}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned %+v, want %+v", users, want)
+	}
+}
+
+func TestOrganizationsService_ListRoleAssignments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":3,"roles":[{"id":1,"name":"security"},{"id":2,"name":"ci"},{"id":3,"name":"broken"}]}`)
+	})
+	mux.HandleFunc("/orgs/o/organization-roles/1/users", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.FormValue("per_page"); got != "100" {
+			t.Errorf("per_page = %q, want 100", got)
+		}
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/organization-roles/1/users?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"login":"a"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"login":"b"}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+	mux.HandleFunc("/orgs/o/organization-roles/1/teams", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"slug":"sec"}]`)
+	})
+	mux.HandleFunc("/orgs/o/organization-roles/2/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/orgs/o/organization-roles/2/teams", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/orgs/o/organization-roles/3/users", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Server Error"}`, http.StatusInternalServerError)
+	})
+
+	got, _, err := client.Organizations.ListRoleAssignments(context.Background(), "o")
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Organizations.ListRoleAssignments returned error %v, want *BatchError", err)
+	}
+	if _, ok := batchErr.Errors["broken"]; !ok || len(batchErr.Errors) != 1 {
+		t.Errorf("BatchError.Errors = %v, want only an error for role broken", batchErr.Errors)
+	}
+
+	want := map[string]*OrgRoleAssignments{
+		"security": {
+			Role:  &CustomOrgRole{ID: Int64(1), Name: String("security")},
+			Users: []*User{{Login: String("a")}, {Login: String("b")}},
+			Teams: []*Team{{Slug: String("sec")}},
+		},
+		"ci": {
+			Role: &CustomOrgRole{ID: Int64(2), Name: String("ci")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.ListRoleAssignments returned %+v, want %+v", got, want)
+	}
+}
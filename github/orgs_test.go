@@ -83,6 +83,39 @@ func TestOrganizationsService_ListAll(t *testing.T) {
 	}
 }
 
+func TestOrganizationsService_ListAllFunc(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch since := r.FormValue("since"); since {
+		case "10":
+			fmt.Fprint(w, `[{"id":11},{"id":12}]`)
+		case "12":
+			// A misbehaving endpoint returning the same page must not loop forever.
+			fmt.Fprint(w, `[{"id":12}]`)
+		default:
+			t.Errorf("unexpected since %q", since)
+		}
+	})
+
+	var got []int64
+	_, err := client.Organizations.ListAllFunc(context.Background(), &ListSinceOptions{Since: 10}, func(orgs []*Organization) error {
+		for _, o := range orgs {
+			got = append(got, o.GetID())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Organizations.ListAllFunc returned error: %v", err)
+	}
+
+	if want := []int64{11, 12, 12}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.ListAllFunc listed organization IDs %v, want %v", got, want)
+	}
+}
+
 func TestOrganizationsService_List_authenticatedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -220,6 +253,68 @@ func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestOrganizationsService_GetRepositoryDefaultSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeMemberAllowedRepoCreationTypePreview)
+		fmt.Fprint(w, `{
+			"login": "o",
+			"id": 1,
+			"default_repository_permission": "write",
+			"members_can_create_repositories": true,
+			"members_can_create_public_repositories": false,
+			"members_can_create_private_repositories": true,
+			"members_can_create_internal_repositories": true,
+			"members_can_fork_private_repositories": false
+		}`)
+	})
+
+	settings, _, err := client.Organizations.GetRepositoryDefaultSettings(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Organizations.GetRepositoryDefaultSettings returned error: %v", err)
+	}
+
+	want := &OrgRepositoryDefaultSettings{
+		DefaultRepoPermission:         String("write"),
+		MembersCanCreateRepos:         Bool(true),
+		MembersCanCreatePublicRepos:   Bool(false),
+		MembersCanCreatePrivateRepos:  Bool(true),
+		MembersCanCreateInternalRepos: Bool(true),
+		MembersCanForkPrivateRepos:    Bool(false),
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Organizations.GetRepositoryDefaultSettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestOrganizationsService_EditRepositoryDefaultSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &OrgRepositoryDefaultSettings{
+		DefaultRepoPermission:       String("none"),
+		MembersCanCreatePublicRepos: Bool(false),
+	}
+
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"default_repository_permission":"none","members_can_create_public_repositories":false}`+"\n")
+		fmt.Fprint(w, `{"login":"o","default_repository_permission":"none","members_can_create_public_repositories":false}`)
+	})
+
+	settings, _, err := client.Organizations.EditRepositoryDefaultSettings(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Organizations.EditRepositoryDefaultSettings returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(settings, input) {
+		t.Errorf("Organizations.EditRepositoryDefaultSettings returned %+v, want %+v", settings, input)
+	}
+}
+
 func TestOrganizationsService_ListInstallations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -9,6 +9,9 @@ package github
 type Package struct {
 	ID             *int64           `json:"id,omitempty"`
 	Name           *string          `json:"name,omitempty"`
+	Namespace      *string          `json:"namespace,omitempty"`
+	Description    *string          `json:"description,omitempty"`
+	Ecosystem      *string          `json:"ecosystem,omitempty"`
 	PackageType    *string          `json:"package_type,omitempty"`
 	HTMLURL        *string          `json:"html_url,omitempty"`
 	CreatedAt      *Timestamp       `json:"created_at,omitempty"`
@@ -0,0 +1,124 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "errors"
+
+// ErrMaxPagesExceeded is returned by the methods that page through a result
+// set on their own, such as the ListXxxAll helpers, when they stop after
+// fetching the maximum number of pages set with Client.WithMaxPages while
+// further pages remain. Methods returning a list return the items of the
+// pages fetched along with it, processed as usual; methods that search the
+// pages for a single result, such as RepositoriesService.GetRuleSuiteForPush,
+// return only the error if they did not find it.
+var ErrMaxPagesExceeded = errors.New("github: maximum number of pages fetched")
+
+// WithMaxPages returns a copy of c (see Clone) whose methods that page
+// through a result set on their own, such as the ListXxxAll helpers, fetch at
+// most n pages, and return ErrMaxPagesExceeded if further pages remain. This
+// guards long-running jobs against runaway pagination on huge result sets or
+// misbehaving endpoints. A value of n less than 1 removes the limit.
+//
+// Methods listing a single page, and loops driven by PaginateCursor, are not
+// affected.
+func (c *Client) WithMaxPages(n int) *Client {
+	c2 := c.Clone()
+	if n < 0 {
+		n = 0
+	}
+	c2.maxPages = n
+	return c2
+}
+
+// paginate calls fetch repeatedly, advancing opts.Page to the next page
+// reported by GitHub, until there are no further pages or fetch returns an
+// error. fetch is expected to issue the request using opts. The response for
+// the last page fetched is returned. If maxPages is positive, paginate stops
+// after that many pages, returning ErrMaxPagesExceeded if there are further
+// pages.
+//
+// The ListXxxAll helpers use paginate to drain offset-paginated endpoints.
+func paginate(maxPages int, opts *ListOptions, fetch func() (*Response, error)) (*Response, error) {
+	for pages := 1; ; pages++ {
+		resp, err := fetch()
+		if err != nil || resp.NextPage == 0 {
+			return resp, err
+		}
+		if pages == maxPages {
+			return resp, ErrMaxPagesExceeded
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// paginateSince calls fetch repeatedly, setting opts.Since to the ID of the
+// last item fetch reports, until fetch reports an empty page (a last ID of 0)
+// or returns an error. It also stops if the last ID does not advance, to
+// avoid looping forever on a misbehaving endpoint. The response for the last
+// page fetched is returned. If maxPages is positive, paginateSince stops after
+// that many pages, returning ErrMaxPagesExceeded unless the last page was
+// empty.
+func paginateSince(maxPages int, opts *ListSinceOptions, fetch func() (lastID int64, resp *Response, err error)) (*Response, error) {
+	for pages := 1; ; pages++ {
+		lastID, resp, err := fetch()
+		if err != nil || lastID <= opts.Since {
+			return resp, err
+		}
+		if pages == maxPages {
+			return resp, ErrMaxPagesExceeded
+		}
+		opts.Since = lastID
+	}
+}
+
+// PaginateCursor calls fetch repeatedly, advancing opts to the next page
+// reported by GitHub, until there are no further pages or fetch returns an
+// error. fetch is expected to issue the request using opts, for an endpoint
+// using cursor pagination, such as OrganizationsService.GetAuditLog. The
+// response for the last page fetched is returned.
+//
+// Both forms of cursors are followed: opts.After is set to Response.After,
+// for endpoints with before/after cursors, and opts.Page to
+// Response.NextPageToken, for endpoints with page tokens. Iteration also
+// stops if the cursor does not advance, to avoid looping forever on a
+// misbehaving endpoint.
+//
+// For instance, to collect every audit log entry of an organization:
+//
+//	opts := &github.GetAuditLogOptions{}
+//	var all []*github.AuditEntry
+//	_, err := github.PaginateCursor(&opts.ListCursorOptions, func() (*github.Response, error) {
+//		entries, resp, err := client.Organizations.GetAuditLog(ctx, org, opts)
+//		all = append(all, entries...)
+//		return resp, err
+//	})
+func PaginateCursor(opts *ListCursorOptions, fetch func() (*Response, error)) (*Response, error) {
+	return paginateCursor(0, opts, fetch)
+}
+
+// paginateCursor is PaginateCursor, stopping after maxPages pages if it is
+// positive and returning ErrMaxPagesExceeded if there are further pages.
+func paginateCursor(maxPages int, opts *ListCursorOptions, fetch func() (*Response, error)) (*Response, error) {
+	for pages := 1; ; pages++ {
+		resp, err := fetch()
+		if err != nil {
+			return resp, err
+		}
+		var next bool
+		switch {
+		case resp.After != "" && resp.After != opts.After:
+			opts.After, next = resp.After, true
+		case resp.NextPageToken != "" && resp.NextPageToken != opts.Page:
+			opts.Page, next = resp.NextPageToken, true
+		}
+		if !next {
+			return resp, nil
+		}
+		if pages == maxPages {
+			return resp, ErrMaxPagesExceeded
+		}
+	}
+}
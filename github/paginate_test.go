@@ -0,0 +1,245 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPaginate_maxPages(t *testing.T) {
+	tests := []struct {
+		maxPages, lastPage int
+		wantPages          int
+		wantErr            error
+	}{
+		{maxPages: 0, lastPage: 5, wantPages: 5},
+		{maxPages: 2, lastPage: 5, wantPages: 2, wantErr: ErrMaxPagesExceeded},
+		{maxPages: 5, lastPage: 5, wantPages: 5},
+		{maxPages: 10, lastPage: 5, wantPages: 5},
+	}
+	for _, tt := range tests {
+		opts := &ListOptions{}
+		pages := 0
+		_, err := paginate(tt.maxPages, opts, func() (*Response, error) {
+			pages++
+			resp := &Response{}
+			if pages < tt.lastPage {
+				resp.NextPage = pages + 1
+			}
+			return resp, nil
+		})
+		if err != tt.wantErr {
+			t.Errorf("paginate(%v) returned error %v, want %v", tt.maxPages, err, tt.wantErr)
+		}
+		if pages != tt.wantPages {
+			t.Errorf("paginate(%v) fetched %v pages, want %v", tt.maxPages, pages, tt.wantPages)
+		}
+	}
+}
+
+func TestPaginateSince_maxPages(t *testing.T) {
+	opts := &ListSinceOptions{}
+	pages := 0
+	_, err := paginateSince(3, opts, func() (int64, *Response, error) {
+		pages++
+		return int64(pages * 10), &Response{}, nil
+	})
+	if err != ErrMaxPagesExceeded {
+		t.Errorf("paginateSince returned error %v, want ErrMaxPagesExceeded", err)
+	}
+	if pages != 3 {
+		t.Errorf("paginateSince fetched %v pages, want 3", pages)
+	}
+	if opts.Since != 20 {
+		t.Errorf("paginateSince left opts.Since at %v, want 20", opts.Since)
+	}
+}
+
+func TestPaginateCursor_maxPages(t *testing.T) {
+	opts := &ListCursorOptions{}
+	pages := 0
+	_, err := paginateCursor(2, opts, func() (*Response, error) {
+		pages++
+		return &Response{After: fmt.Sprintf("c%v", pages)}, nil
+	})
+	if err != ErrMaxPagesExceeded {
+		t.Errorf("paginateCursor returned error %v, want ErrMaxPagesExceeded", err)
+	}
+	if pages != 2 {
+		t.Errorf("paginateCursor fetched %v pages, want 2", pages)
+	}
+
+	// The last page reports no further cursor, so the limit is not exceeded.
+	opts = &ListCursorOptions{}
+	pages = 0
+	_, err = paginateCursor(2, opts, func() (*Response, error) {
+		pages++
+		if pages == 2 {
+			return &Response{}, nil
+		}
+		return &Response{After: "c1"}, nil
+	})
+	if err != nil {
+		t.Errorf("paginateCursor returned error %v, want nil", err)
+	}
+}
+
+func TestClient_WithMaxPages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/deployments/1/statuses?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":3}]`)
+		case "2":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/deployments/1/statuses?page=3>; rel="next"`)
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	limited := client.WithMaxPages(2)
+	statuses, resp, err := limited.Repositories.ListDeploymentStatusesAll(context.Background(), "o", "r", 1, nil)
+	if err != ErrMaxPagesExceeded {
+		t.Errorf("Repositories.ListDeploymentStatusesAll returned error %v, want ErrMaxPagesExceeded", err)
+	}
+	want := []*DeploymentStatus{{ID: Int64(3)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("Repositories.ListDeploymentStatusesAll returned %+v, want %+v", statuses, want)
+	}
+	if resp.NextPage != 3 {
+		t.Errorf("Repositories.ListDeploymentStatusesAll returned NextPage %v, want 3", resp.NextPage)
+	}
+
+	if client.maxPages != 0 {
+		t.Errorf("WithMaxPages modified the original client: maxPages = %v", client.maxPages)
+	}
+	if got := limited.WithMaxPages(-1).maxPages; got != 0 {
+		t.Errorf("WithMaxPages(-1) set maxPages = %v, want 0", got)
+	}
+}
+
+func TestClient_WithMaxPages_partialResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/comments?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"id":1},{"id":2,"in_reply_to_id":1}]`)
+	})
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...h?page=2>; rel="next"`)
+		fmt.Fprint(w, `{"commits":[{"sha":"c2","parents":[{"sha":"c1"}]},{"sha":"c1","parents":[{"sha":"b"}]}]}`)
+	})
+
+	ctx := context.Background()
+	limited := client.WithMaxPages(1)
+
+	threads, _, err := limited.PullRequests.ListReviewThreads(ctx, "o", "r", 1)
+	if err != ErrMaxPagesExceeded {
+		t.Errorf("PullRequests.ListReviewThreads returned error %v, want ErrMaxPagesExceeded", err)
+	}
+	if len(threads) != 1 || len(threads[0].Replies) != 1 {
+		t.Errorf("PullRequests.ListReviewThreads returned %+v, want one thread with one reply", threads)
+	}
+
+	commits, _, err := limited.Repositories.ListCommitsInTopologicalOrder(ctx, "o", "r", "b", "h")
+	if err != ErrMaxPagesExceeded {
+		t.Errorf("Repositories.ListCommitsInTopologicalOrder returned error %v, want ErrMaxPagesExceeded", err)
+	}
+	if len(commits) != 2 || commits[0].GetSHA() != "c1" {
+		t.Errorf("Repositories.ListCommitsInTopologicalOrder returned %+v, want c1 then c2", commits)
+	}
+}
+
+func TestPaginateCursor_auditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("phrase"); got != "action:repo.create" {
+			t.Errorf("phrase = %q, want %q", got, "action:repo.create")
+		}
+		switch after := r.FormValue("after"); after {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c1&before=>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id":"d1"},{"_document_id":"d2"}]`)
+		case "c1":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2&before=>; rel="next", <https://api.github.com/orgs/o/audit-log?after=&before=c1>; rel="prev"`)
+			fmt.Fprint(w, `[{"_document_id":"d3"}]`)
+		case "c2":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=&before=c2>; rel="prev"`)
+			fmt.Fprint(w, `[{"_document_id":"d4"}]`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	opts := &GetAuditLogOptions{Phrase: "action:repo.create"}
+	var ids []string
+	_, err := PaginateCursor(&opts.ListCursorOptions, func() (*Response, error) {
+		entries, resp, err := client.Organizations.GetAuditLog(context.Background(), "o", opts)
+		for _, e := range entries {
+			ids = append(ids, e.GetDocumentID())
+		}
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("PaginateCursor returned error: %v", err)
+	}
+
+	if want := []string{"d1", "d2", "d3", "d4"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("PaginateCursor fetched entries %v, want %v", ids, want)
+	}
+	if opts.After != "c2" {
+		t.Errorf("opts.After = %q, want %q", opts.After, "c2")
+	}
+}
+
+func TestPaginateCursor_pageToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/team-sync/groups", func(w http.ResponseWriter, r *http.Request) {
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/team-sync/groups?page=tok2>; rel="next"`)
+			fmt.Fprint(w, `{"groups": [{"group_id": "1"}]}`)
+		case "tok2":
+			fmt.Fprint(w, `{"groups": [{"group_id": "2"}]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &ListCursorOptions{}
+	var ids []string
+	_, err := PaginateCursor(opts, func() (*Response, error) {
+		groups, resp, err := client.Teams.ListIDPGroupsInOrganization(context.Background(), "o", opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, g := range groups.Groups {
+			ids = append(ids, g.GetGroupID())
+		}
+		return resp, err
+	})
+	if err != nil {
+		t.Fatalf("PaginateCursor returned error: %v", err)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("PaginateCursor fetched groups %v, want %v", ids, want)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// DiffPatch returns a minimal PATCH request body that changes current into
+// desired. current and desired are typically the same type, such as a
+// *Repository fetched from GitHub and a *Repository describing the wanted
+// settings.
+//
+// Both values are encoded as JSON, so the field names are their JSON names
+// and fields omitted by their json tags, such as nil pointers, are ignored.
+// The result holds the fields of desired whose values differ from current,
+// or which current lacks. Nested objects are compared field by field in the
+// same way, and only their changed fields are included. A field cannot be
+// cleared by leaving it unset in desired; it must be set to its empty value.
+//
+// If nothing changed, an empty map is returned. An error is returned if
+// either value does not encode to a JSON object.
+func DiffPatch(current, desired interface{}) (map[string]interface{}, error) {
+	cur, err := jsonObject(current)
+	if err != nil {
+		return nil, fmt.Errorf("encoding current: %w", err)
+	}
+	want, err := jsonObject(desired)
+	if err != nil {
+		return nil, fmt.Errorf("encoding desired: %w", err)
+	}
+	return diffObjects(cur, want), nil
+}
+
+// jsonObject round-trips v through JSON into a generic JSON object.
+func jsonObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, fmt.Errorf("%T is not a JSON object", v)
+	}
+	return m, nil
+}
+
+func diffObjects(cur, want map[string]interface{}) map[string]interface{} {
+	diff := make(map[string]interface{})
+	for k, w := range want {
+		c, ok := cur[k]
+		if !ok {
+			diff[k] = w
+			continue
+		}
+		wm, wok := w.(map[string]interface{})
+		cm, cok := c.(map[string]interface{})
+		if wok && cok {
+			if d := diffObjects(cm, wm); len(d) > 0 {
+				diff[k] = d
+			}
+			continue
+		}
+		if !reflect.DeepEqual(c, w) {
+			diff[k] = w
+		}
+	}
+	return diff
+}
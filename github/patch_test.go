@@ -0,0 +1,85 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffPatch_repository(t *testing.T) {
+	current := &Repository{
+		ID:            Int64(1),
+		Name:          String("r"),
+		Description:   String("old"),
+		HasWiki:       Bool(true),
+		DefaultBranch: String("main"),
+		Topics:        []string{"go"},
+		License:       &License{Key: String("mit"), Name: String("MIT License")},
+	}
+	desired := &Repository{
+		Name:          String("r"),
+		Description:   String("new"),
+		HasWiki:       Bool(false),
+		HasIssues:     Bool(true),
+		DefaultBranch: String("main"),
+		Topics:        []string{"go", "github"},
+		License:       &License{Key: String("apache-2.0"), Name: String("MIT License")},
+	}
+
+	got, err := DiffPatch(current, desired)
+	if err != nil {
+		t.Fatalf("DiffPatch returned error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"description": "new",
+		"has_wiki":    false,
+		"has_issues":  true,
+		"topics":      []interface{}{"go", "github"},
+		"license":     map[string]interface{}{"key": "apache-2.0"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffPatch returned %v, want %v", got, want)
+	}
+}
+
+func TestDiffPatch_unchanged(t *testing.T) {
+	current := &Hook{ID: Int64(1), Active: Bool(true), Events: []string{"push"}}
+	desired := &Hook{Active: Bool(true), Events: []string{"push"}}
+
+	got, err := DiffPatch(current, desired)
+	if err != nil {
+		t.Fatalf("DiffPatch returned error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("DiffPatch returned %v, want empty", got)
+	}
+}
+
+func TestDiffPatch_organization(t *testing.T) {
+	current := &Organization{Login: String("o"), DefaultRepoPermission: String("read"), MembersCanCreateRepos: Bool(true)}
+	desired := &Organization{DefaultRepoPermission: String("none"), MembersCanCreateRepos: Bool(true)}
+
+	got, err := DiffPatch(current, desired)
+	if err != nil {
+		t.Fatalf("DiffPatch returned error: %v", err)
+	}
+
+	want := map[string]interface{}{"default_repository_permission": "none"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffPatch returned %v, want %v", got, want)
+	}
+}
+
+func TestDiffPatch_notObject(t *testing.T) {
+	if _, err := DiffPatch([]string{"a"}, &Repository{}); err == nil {
+		t.Error("DiffPatch with a slice returned no error, want error")
+	}
+	if _, err := DiffPatch(&Repository{}, (*Repository)(nil)); err == nil {
+		t.Error("DiffPatch with a nil pointer returned no error, want error")
+	}
+}
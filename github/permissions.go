@@ -0,0 +1,74 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PermissionLevel is a repository permission, ordered so that levels can be
+// compared: each level includes the access granted by the lower ones.
+type PermissionLevel int
+
+// Repository permission levels, from lowest to highest.
+const (
+	PermissionNone PermissionLevel = iota
+	PermissionPull
+	PermissionTriage
+	PermissionPush
+	PermissionMaintain
+	PermissionAdmin
+)
+
+var permissionLevelNames = [...]string{
+	PermissionNone:     "none",
+	PermissionPull:     "pull",
+	PermissionTriage:   "triage",
+	PermissionPush:     "push",
+	PermissionMaintain: "maintain",
+	PermissionAdmin:    "admin",
+}
+
+// ParsePermission returns the level of the repository permission s, as
+// returned by the API, case-insensitively. The organization base permissions
+// and collaborator permission levels "read" and "write" are the same as
+// "pull" and "push"; "none" and the empty string are PermissionNone.
+//
+// Any other name is taken to be a custom repository role. Since every custom
+// role includes read access, it is ranked as PermissionPull; to rank a
+// custom role by the role it inherits from, parse its base role instead.
+func ParsePermission(s string) PermissionLevel {
+	switch strings.ToLower(s) {
+	case "", "none":
+		return PermissionNone
+	case "pull", "read":
+		return PermissionPull
+	case "triage":
+		return PermissionTriage
+	case "push", "write":
+		return PermissionPush
+	case "maintain":
+		return PermissionMaintain
+	case "admin":
+		return PermissionAdmin
+	}
+	return PermissionPull
+}
+
+// AtLeast reports whether l grants at least the access other grants.
+func (l PermissionLevel) AtLeast(other PermissionLevel) bool {
+	return l >= other
+}
+
+// String returns the name of l as used by the API for team permissions,
+// such as "pull" or "admin".
+func (l PermissionLevel) String() string {
+	if l < 0 || int(l) >= len(permissionLevelNames) {
+		return fmt.Sprintf("PermissionLevel(%d)", int(l))
+	}
+	return permissionLevelNames[l]
+}
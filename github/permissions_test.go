@@ -0,0 +1,61 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "testing"
+
+func TestParsePermission(t *testing.T) {
+	tests := []struct {
+		in   string
+		want PermissionLevel
+	}{
+		{"", PermissionNone},
+		{"none", PermissionNone},
+		{"pull", PermissionPull},
+		{"read", PermissionPull},
+		{"triage", PermissionTriage},
+		{"push", PermissionPush},
+		{"write", PermissionPush},
+		{"maintain", PermissionMaintain},
+		{"admin", PermissionAdmin},
+		{"Admin", PermissionAdmin},
+		// Custom repository roles all include read access.
+		{"security-engineer", PermissionPull},
+	}
+
+	for _, tt := range tests {
+		if got := ParsePermission(tt.in); got != tt.want {
+			t.Errorf("ParsePermission(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPermissionLevel_AtLeast(t *testing.T) {
+	ordered := []PermissionLevel{PermissionNone, PermissionPull, PermissionTriage, PermissionPush, PermissionMaintain, PermissionAdmin}
+	for i, l := range ordered {
+		for j, other := range ordered {
+			if got, want := l.AtLeast(other), i >= j; got != want {
+				t.Errorf("%v.AtLeast(%v) = %v, want %v", l, other, got, want)
+			}
+		}
+	}
+
+	if !ParsePermission("maintain").AtLeast(ParsePermission("triage")) {
+		t.Error("maintain is not at least triage")
+	}
+	if ParsePermission("custom-role").AtLeast(PermissionTriage) {
+		t.Error("custom role is at least triage, want it ranked as pull")
+	}
+}
+
+func TestPermissionLevel_String(t *testing.T) {
+	if got, want := PermissionMaintain.String(), "maintain"; got != want {
+		t.Errorf("PermissionMaintain.String() = %q, want %q", got, want)
+	}
+	if got, want := PermissionLevel(42).String(), "PermissionLevel(42)"; got != want {
+		t.Errorf("PermissionLevel(42).String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProjectsV2Service provides access to the Projects (V2) functions of the
+// GitHub GraphQL API. Projects (V2) have no REST API; the methods of this
+// service are thin wrappers around a handful of GraphQL queries and mutations.
+//
+// The list methods use cursor pagination: pass Response.NextPageToken as
+// ListCursorOptions.Page to fetch the next page. ListCursorOptions.PerPage
+// defaults to 30 and may not exceed 100.
+//
+// GitHub API docs: https://docs.github.com/en/issues/planning-and-tracking-with-projects/automating-your-project/using-the-api-to-manage-projects
+type ProjectsV2Service service
+
+// ProjectV2 represents a GitHub Project (V2).
+type ProjectV2 struct {
+	ID               *string    `json:"id,omitempty"`
+	Number           *int       `json:"number,omitempty"`
+	Title            *string    `json:"title,omitempty"`
+	ShortDescription *string    `json:"shortDescription,omitempty"`
+	URL              *string    `json:"url,omitempty"`
+	Closed           *bool      `json:"closed,omitempty"`
+	Public           *bool      `json:"public,omitempty"`
+	CreatedAt        *Timestamp `json:"createdAt,omitempty"`
+	UpdatedAt        *Timestamp `json:"updatedAt,omitempty"`
+}
+
+func (p ProjectV2) String() string {
+	return Stringify(p)
+}
+
+// ProjectV2Item represents an item of a Project (V2).
+type ProjectV2Item struct {
+	ID *string `json:"id,omitempty"`
+	// Type is the type of the item. Possible values are: ISSUE, PULL_REQUEST,
+	// DRAFT_ISSUE, REDACTED.
+	Type        *string                    `json:"type,omitempty"`
+	IsArchived  *bool                      `json:"isArchived,omitempty"`
+	Content     *ProjectV2ItemContent      `json:"content,omitempty"`
+	FieldValues []*ProjectV2ItemFieldValue `json:"fieldValues,omitempty"`
+}
+
+func (p ProjectV2Item) String() string {
+	return Stringify(p)
+}
+
+// ProjectV2ItemContent represents the issue, pull request or draft issue
+// a ProjectV2Item refers to.
+type ProjectV2ItemContent struct {
+	// Typename is one of: Issue, PullRequest, DraftIssue.
+	Typename *string `json:"__typename,omitempty"`
+	ID       *string `json:"id,omitempty"`
+	Number   *int    `json:"number,omitempty"` // Not set for draft issues.
+	Title    *string `json:"title,omitempty"`
+	URL      *string `json:"url,omitempty"` // Not set for draft issues.
+}
+
+// ProjectV2ItemFieldValue represents the value of a project field for a
+// ProjectV2Item. Which of the value fields is set depends on the type of the
+// field.
+type ProjectV2ItemFieldValue struct {
+	// Typename is one of: ProjectV2ItemFieldTextValue,
+	// ProjectV2ItemFieldNumberValue, ProjectV2ItemFieldDateValue,
+	// ProjectV2ItemFieldSingleSelectValue.
+	Typename *string         `json:"__typename,omitempty"`
+	Field    *ProjectV2Field `json:"field,omitempty"`
+
+	Text   *string  `json:"text,omitempty"`
+	Number *float64 `json:"number,omitempty"`
+	// Date is formatted as YYYY-MM-DD.
+	Date *string `json:"date,omitempty"`
+	// Name and OptionID are set for single-select fields.
+	Name     *string `json:"name,omitempty"`
+	OptionID *string `json:"optionId,omitempty"`
+}
+
+// ProjectV2Field identifies a field of a Project (V2).
+type ProjectV2Field struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// ProjectV2FieldValue represents the new value of a project field, as used by
+// ProjectsV2Service.UpdateItemFieldValue. Exactly one of its fields must be set.
+type ProjectV2FieldValue struct {
+	Text   *string  `json:"text,omitempty"`
+	Number *float64 `json:"number,omitempty"`
+	// Date is formatted as YYYY-MM-DD.
+	Date                 *string `json:"date,omitempty"`
+	SingleSelectOptionID *string `json:"singleSelectOptionId,omitempty"`
+	IterationID          *string `json:"iterationId,omitempty"`
+}
+
+// projectV2Fields selects the fields of a ProjectV2.
+const projectV2Fields = `id number title shortDescription url closed public createdAt updatedAt`
+
+// graphQLPageInfo is the pagination information of a GraphQL connection.
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// cursorVariables returns the GraphQL variables "first" and "after" for opts.
+func cursorVariables(opts *ListCursorOptions) map[string]interface{} {
+	vars := map[string]interface{}{"first": 30, "after": nil}
+	if opts != nil {
+		if opts.PerPage != 0 {
+			vars["first"] = opts.PerPage
+		}
+		if opts.Page != "" {
+			vars["after"] = opts.Page
+		}
+	}
+	return vars
+}
+
+// setNextPageToken sets resp.NextPageToken from the page info of a GraphQL
+// connection.
+func setNextPageToken(resp *Response, pageInfo graphQLPageInfo) {
+	if resp != nil && pageInfo.HasNextPage {
+		resp.NextPageToken = pageInfo.EndCursor
+	}
+}
+
+// ListForUser lists the projects (V2) owned by a user.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#user
+func (s *ProjectsV2Service) ListForUser(ctx context.Context, user string, opts *ListCursorOptions) ([]*ProjectV2, *Response, error) {
+	return s.listProjects(ctx, "user", user, opts)
+}
+
+// ListForOrg lists the projects (V2) owned by an organization.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#organization
+func (s *ProjectsV2Service) ListForOrg(ctx context.Context, org string, opts *ListCursorOptions) ([]*ProjectV2, *Response, error) {
+	return s.listProjects(ctx, "organization", org, opts)
+}
+
+// listProjects lists the projects (V2) of the owner with the given login.
+// ownerField is the GraphQL root field to look the owner up with.
+func (s *ProjectsV2Service) listProjects(ctx context.Context, ownerField, login string, opts *ListCursorOptions) ([]*ProjectV2, *Response, error) {
+	query := fmt.Sprintf(`query($login: String!, $first: Int!, $after: String) {
+  owner: %v(login: $login) {
+    projectsV2(first: $first, after: $after) {
+      nodes { %v }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`, ownerField, projectV2Fields)
+
+	vars := cursorVariables(opts)
+	vars["login"] = login
+
+	var result struct {
+		Owner *struct {
+			ProjectsV2 struct {
+				Nodes    []*ProjectV2    `json:"nodes"`
+				PageInfo graphQLPageInfo `json:"pageInfo"`
+			} `json:"projectsV2"`
+		} `json:"owner"`
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Owner == nil {
+		return nil, resp, fmt.Errorf("%v %q not found", ownerField, login)
+	}
+
+	setNextPageToken(resp, result.Owner.ProjectsV2.PageInfo)
+	return result.Owner.ProjectsV2.Nodes, resp, nil
+}
+
+// projectV2FieldValuesFragment selects a page of the field values of a
+// project item.
+const projectV2FieldValuesFragment = `
+fragment fieldValues on ProjectV2ItemFieldValueConnection {
+  nodes {
+    __typename
+    ... on ProjectV2ItemFieldTextValue { text field { ... on ProjectV2FieldCommon { id name } } }
+    ... on ProjectV2ItemFieldNumberValue { number field { ... on ProjectV2FieldCommon { id name } } }
+    ... on ProjectV2ItemFieldDateValue { date field { ... on ProjectV2FieldCommon { id name } } }
+    ... on ProjectV2ItemFieldSingleSelectValue { name optionId field { ... on ProjectV2FieldCommon { id name } } }
+  }
+  pageInfo { hasNextPage endCursor }
+}`
+
+// getProjectV2ItemsQuery lists the items of a project with their content and
+// the first page of their field values.
+const getProjectV2ItemsQuery = `query($id: ID!, $first: Int!, $after: String) {
+  node(id: $id) {
+    ... on ProjectV2 {
+      items(first: $first, after: $after) {
+        nodes {
+          id
+          type
+          isArchived
+          content {
+            __typename
+            ... on Issue { id number title url }
+            ... on PullRequest { id number title url }
+            ... on DraftIssue { id title }
+          }
+          fieldValues(first: 100) { ...fieldValues }
+        }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}` + projectV2FieldValuesFragment
+
+// getProjectV2ItemFieldValuesQuery lists the further field values of a
+// project item.
+const getProjectV2ItemFieldValuesQuery = `query($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on ProjectV2Item {
+      fieldValues(first: 100, after: $after) { ...fieldValues }
+    }
+  }
+}` + projectV2FieldValuesFragment
+
+// projectV2FieldValues is a page of the field values of a project item.
+type projectV2FieldValues struct {
+	Nodes    []*ProjectV2ItemFieldValue `json:"nodes"`
+	PageInfo graphQLPageInfo            `json:"pageInfo"`
+}
+
+// GetItems lists the items of the project (V2) with the given node ID,
+// including the values of their text, number, date and single-select
+// fields. Values of other field types are omitted. The field values of an
+// item are fetched 100 at a time, so an item with more values takes further
+// requests.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#projectv2
+func (s *ProjectsV2Service) GetItems(ctx context.Context, projectID string, opts *ListCursorOptions) ([]*ProjectV2Item, *Response, error) {
+	vars := cursorVariables(opts)
+	vars["id"] = projectID
+
+	var result struct {
+		Node *struct {
+			Items *struct {
+				Nodes []*struct {
+					ProjectV2Item
+					FieldValues projectV2FieldValues `json:"fieldValues"`
+				} `json:"nodes"`
+				PageInfo graphQLPageInfo `json:"pageInfo"`
+			} `json:"items"`
+		} `json:"node"`
+	}
+	resp, err := s.client.graphQL(ctx, getProjectV2ItemsQuery, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil || result.Node.Items == nil {
+		return nil, resp, fmt.Errorf("project %q not found", projectID)
+	}
+
+	items := make([]*ProjectV2Item, 0, len(result.Node.Items.Nodes))
+	for _, node := range result.Node.Items.Nodes {
+		item := node.ProjectV2Item
+		values := node.FieldValues
+		for {
+			for _, v := range values.Nodes {
+				// Values of unsupported field types only have a __typename.
+				if v.Field != nil {
+					item.FieldValues = append(item.FieldValues, v)
+				}
+			}
+			if !values.PageInfo.HasNextPage {
+				break
+			}
+			next, valuesResp, err := s.getItemFieldValues(ctx, item.GetID(), values.PageInfo.EndCursor)
+			if err != nil {
+				return nil, valuesResp, err
+			}
+			values = *next
+		}
+		items = append(items, &item)
+	}
+
+	setNextPageToken(resp, result.Node.Items.PageInfo)
+	return items, resp, nil
+}
+
+// getItemFieldValues fetches the page of field values of the project item
+// with the given node ID that follows the cursor after.
+func (s *ProjectsV2Service) getItemFieldValues(ctx context.Context, itemID, after string) (*projectV2FieldValues, *Response, error) {
+	vars := map[string]interface{}{"id": itemID, "after": after}
+	var result struct {
+		Node *struct {
+			FieldValues *projectV2FieldValues `json:"fieldValues"`
+		} `json:"node"`
+	}
+	resp, err := s.client.graphQL(ctx, getProjectV2ItemFieldValuesQuery, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil || result.Node.FieldValues == nil {
+		return nil, resp, fmt.Errorf("project item %q not found", itemID)
+	}
+	return result.Node.FieldValues, resp, nil
+}
+
+// updateProjectV2ItemFieldValueMutation sets the value of a field of a
+// project item.
+const updateProjectV2ItemFieldValueMutation = `mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
+  updateProjectV2ItemFieldValue(input: {projectId: $projectId, itemId: $itemId, fieldId: $fieldId, value: $value}) {
+    projectV2Item { id }
+  }
+}`
+
+// UpdateItemFieldValue sets the value of the field with the given node ID for
+// an item of a project (V2).
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#updateprojectv2itemfieldvalue
+func (s *ProjectsV2Service) UpdateItemFieldValue(ctx context.Context, projectID, itemID, fieldID string, value *ProjectV2FieldValue) (*Response, error) {
+	vars := map[string]interface{}{
+		"projectId": projectID,
+		"itemId":    itemID,
+		"fieldId":   fieldID,
+		"value":     value,
+	}
+	return s.client.graphQL(ctx, updateProjectV2ItemFieldValueMutation, vars, nil)
+}
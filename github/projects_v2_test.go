@@ -0,0 +1,214 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// graphQLRequestBody is the body of a request to the GraphQL API.
+type graphQLRequestBody struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+func decodeGraphQLRequest(t *testing.T, r *http.Request) *graphQLRequestBody {
+	t.Helper()
+	body := new(graphQLRequestBody)
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		t.Fatalf("decoding GraphQL request: %v", err)
+	}
+	return body
+}
+
+func TestProjectsV2Service_ListForUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := decodeGraphQLRequest(t, r)
+		if !strings.Contains(body.Query, "owner: user(login: $login)") {
+			t.Errorf("GraphQL query %q does not look up a user", body.Query)
+		}
+		want := map[string]interface{}{"login": "u", "first": float64(2), "after": "c1"}
+		if !reflect.DeepEqual(body.Variables, want) {
+			t.Errorf("GraphQL variables are %+v, want %+v", body.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"owner":{"projectsV2":{"nodes":[{"id":"PVT_1","number":1,"title":"Roadmap","closed":false,"createdAt":`+referenceTimeStr+`}],"pageInfo":{"hasNextPage":true,"endCursor":"c2"}}}}}`)
+	})
+
+	opts := &ListCursorOptions{Page: "c1", PerPage: 2}
+	projects, resp, err := client.ProjectsV2.ListForUser(context.Background(), "u", opts)
+	if err != nil {
+		t.Fatalf("ProjectsV2.ListForUser returned error: %v", err)
+	}
+
+	want := []*ProjectV2{{
+		ID:        String("PVT_1"),
+		Number:    Int(1),
+		Title:     String("Roadmap"),
+		Closed:    Bool(false),
+		CreatedAt: &Timestamp{referenceTime},
+	}}
+	if !reflect.DeepEqual(projects, want) {
+		t.Errorf("ProjectsV2.ListForUser returned %+v, want %+v", projects, want)
+	}
+	if got, want := resp.NextPageToken, "c2"; got != want {
+		t.Errorf("ProjectsV2.ListForUser NextPageToken is %q, want %q", got, want)
+	}
+}
+
+func TestProjectsV2Service_ListForOrg_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		body := decodeGraphQLRequest(t, r)
+		if !strings.Contains(body.Query, "owner: organization(login: $login)") {
+			t.Errorf("GraphQL query %q does not look up an organization", body.Query)
+		}
+		fmt.Fprint(w, `{"data":{"owner":null}}`)
+	})
+
+	if _, _, err := client.ProjectsV2.ListForOrg(context.Background(), "o", nil); err == nil {
+		t.Error("ProjectsV2.ListForOrg returned no error for a missing organization")
+	}
+}
+
+func TestProjectsV2Service_GetItems(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := decodeGraphQLRequest(t, r)
+		want := map[string]interface{}{"id": "PVT_1", "first": float64(30), "after": nil}
+		if !reflect.DeepEqual(body.Variables, want) {
+			t.Errorf("GraphQL variables are %+v, want %+v", body.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"node":{"items":{"nodes":[{
+			"id":"PVTI_1","type":"ISSUE","isArchived":false,
+			"content":{"__typename":"Issue","id":"I_1","number":3,"title":"Bug","url":"https://github.com/o/r/issues/3"},
+			"fieldValues":{"nodes":[
+				{"__typename":"ProjectV2ItemFieldTextValue","text":"note","field":{"id":"F_1","name":"Notes"}},
+				{"__typename":"ProjectV2ItemFieldNumberValue","number":2.5,"field":{"id":"F_2","name":"Estimate"}},
+				{"__typename":"ProjectV2ItemFieldDateValue","date":"2021-03-04","field":{"id":"F_3","name":"Due"}},
+				{"__typename":"ProjectV2ItemFieldSingleSelectValue","name":"Done","optionId":"o1","field":{"id":"F_4","name":"Status"}},
+				{"__typename":"ProjectV2ItemFieldLabelValue"}
+			]}
+		}],"pageInfo":{"hasNextPage":false,"endCursor":"c9"}}}}}`)
+	})
+
+	items, resp, err := client.ProjectsV2.GetItems(context.Background(), "PVT_1", nil)
+	if err != nil {
+		t.Fatalf("ProjectsV2.GetItems returned error: %v", err)
+	}
+
+	want := []*ProjectV2Item{{
+		ID:         String("PVTI_1"),
+		Type:       String("ISSUE"),
+		IsArchived: Bool(false),
+		Content: &ProjectV2ItemContent{
+			Typename: String("Issue"),
+			ID:       String("I_1"),
+			Number:   Int(3),
+			Title:    String("Bug"),
+			URL:      String("https://github.com/o/r/issues/3"),
+		},
+		FieldValues: []*ProjectV2ItemFieldValue{
+			{Typename: String("ProjectV2ItemFieldTextValue"), Text: String("note"), Field: &ProjectV2Field{ID: String("F_1"), Name: String("Notes")}},
+			{Typename: String("ProjectV2ItemFieldNumberValue"), Number: Float64(2.5), Field: &ProjectV2Field{ID: String("F_2"), Name: String("Estimate")}},
+			{Typename: String("ProjectV2ItemFieldDateValue"), Date: String("2021-03-04"), Field: &ProjectV2Field{ID: String("F_3"), Name: String("Due")}},
+			{Typename: String("ProjectV2ItemFieldSingleSelectValue"), Name: String("Done"), OptionID: String("o1"), Field: &ProjectV2Field{ID: String("F_4"), Name: String("Status")}},
+		},
+	}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("ProjectsV2.GetItems returned %+v, want %+v", items, want)
+	}
+	if resp.NextPageToken != "" {
+		t.Errorf("ProjectsV2.GetItems NextPageToken is %q, want empty", resp.NextPageToken)
+	}
+}
+
+func TestProjectsV2Service_GetItems_moreFieldValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body := decodeGraphQLRequest(t, r)
+		switch requests {
+		case 1:
+			fmt.Fprint(w, `{"data":{"node":{"items":{"nodes":[{
+				"id":"PVTI_1",
+				"fieldValues":{
+					"nodes":[{"__typename":"ProjectV2ItemFieldTextValue","text":"a","field":{"id":"F_1","name":"A"}}],
+					"pageInfo":{"hasNextPage":true,"endCursor":"v1"}
+				}
+			}],"pageInfo":{"hasNextPage":false,"endCursor":"c1"}}}}}`)
+		case 2:
+			want := map[string]interface{}{"id": "PVTI_1", "after": "v1"}
+			if !reflect.DeepEqual(body.Variables, want) {
+				t.Errorf("GraphQL variables are %+v, want %+v", body.Variables, want)
+			}
+			fmt.Fprint(w, `{"data":{"node":{"fieldValues":{
+				"nodes":[{"__typename":"ProjectV2ItemFieldTextValue","text":"b","field":{"id":"F_2","name":"B"}}],
+				"pageInfo":{"hasNextPage":false,"endCursor":"v2"}
+			}}}}`)
+		default:
+			t.Errorf("unexpected request %v", requests)
+		}
+	})
+
+	items, _, err := client.ProjectsV2.GetItems(context.Background(), "PVT_1", nil)
+	if err != nil {
+		t.Fatalf("ProjectsV2.GetItems returned error: %v", err)
+	}
+
+	want := []*ProjectV2Item{{
+		ID: String("PVTI_1"),
+		FieldValues: []*ProjectV2ItemFieldValue{
+			{Typename: String("ProjectV2ItemFieldTextValue"), Text: String("a"), Field: &ProjectV2Field{ID: String("F_1"), Name: String("A")}},
+			{Typename: String("ProjectV2ItemFieldTextValue"), Text: String("b"), Field: &ProjectV2Field{ID: String("F_2"), Name: String("B")}},
+		},
+	}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("ProjectsV2.GetItems returned %+v, want %+v", items, want)
+	}
+}
+
+func TestProjectsV2Service_UpdateItemFieldValue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := decodeGraphQLRequest(t, r)
+		want := map[string]interface{}{
+			"projectId": "PVT_1",
+			"itemId":    "PVTI_1",
+			"fieldId":   "F_4",
+			"value":     map[string]interface{}{"singleSelectOptionId": "o2"},
+		}
+		if !reflect.DeepEqual(body.Variables, want) {
+			t.Errorf("GraphQL variables are %+v, want %+v", body.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"updateProjectV2ItemFieldValue":{"projectV2Item":{"id":"PVTI_1"}}}}`)
+	})
+
+	value := &ProjectV2FieldValue{SingleSelectOptionID: String("o2")}
+	if _, err := client.ProjectsV2.UpdateItemFieldValue(context.Background(), "PVT_1", "PVTI_1", "F_4", value); err != nil {
+		t.Errorf("ProjectsV2.UpdateItemFieldValue returned error: %v", err)
+	}
+}
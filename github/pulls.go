@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -106,6 +107,39 @@ type PullRequestBranch struct {
 	User  *User       `json:"user,omitempty"`
 }
 
+// PullRequestHeadRepo returns the owner and name of the repository that
+// contains the head branch of pr, the name of that branch, and whether the
+// head repository is a fork different from the base repository. It is the
+// repository that must be used to fetch the contents of the head branch.
+//
+// If the head repository has been deleted, GitHub no longer reports it:
+// repo is then empty, owner is the login of the head user and isFork is true.
+func PullRequestHeadRepo(pr *PullRequest) (owner, repo, ref string, isFork bool) {
+	head, base := pr.GetHead(), pr.GetBase()
+	ref = head.GetRef()
+
+	if head.Repo == nil {
+		return head.GetUser().GetLogin(), "", ref, true
+	}
+
+	owner, repo = head.Repo.GetOwner().GetLogin(), head.Repo.GetName()
+	if owner == "" || repo == "" {
+		if parts := strings.SplitN(head.Repo.GetFullName(), "/", 2); len(parts) == 2 {
+			owner, repo = parts[0], parts[1]
+		}
+	}
+
+	switch {
+	case base.Repo == nil:
+		isFork = head.Repo.GetFork()
+	case head.Repo.ID != nil && base.Repo.ID != nil:
+		isFork = head.Repo.GetID() != base.Repo.GetID()
+	default:
+		isFork = !strings.EqualFold(head.Repo.GetFullName(), base.Repo.GetFullName())
+	}
+	return owner, repo, ref, isFork
+}
+
 // PullRequestListOptions specifies the optional parameters to the
 // PullRequestsService.List method.
 type PullRequestListOptions struct {
@@ -56,7 +56,8 @@ type PullRequestListCommentsOptions struct {
 	// Direction in which to sort comments. Possible values are: asc, desc.
 	Direction string `url:"direction,omitempty"`
 
-	// Since filters comments by time.
+	// Since filters comments to only those updated at or after the given time.
+	// It is sent in RFC 3339 format and omitted when zero.
 	Since time.Time `url:"since,omitempty"`
 
 	ListOptions
@@ -64,7 +65,8 @@ type PullRequestListCommentsOptions struct {
 
 // ListComments lists all comments on the specified pull request. Specifying a
 // pull request number of 0 will return all comments on all pull requests for
-// the repository.
+// the repository. The sort, direction and since options are supported in
+// both cases, which allows polling for new or updated comments incrementally.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#list-review-comments-on-a-pull-request
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#list-review-comments-in-a-repository
@@ -98,6 +100,75 @@ func (s *PullRequestsService) ListComments(ctx context.Context, owner string, re
 	return comments, resp, nil
 }
 
+// ReviewThread is a conversation on a pull request diff: a review comment and
+// the replies to it, as returned by PullRequestsService.ListReviewThreads.
+type ReviewThread struct {
+	// Root is the comment that started the thread.
+	Root *PullRequestComment
+	// Replies are the other comments of the thread, oldest first.
+	Replies []*PullRequestComment
+}
+
+// ListReviewThreads lists all the review comments on a pull request, grouped
+// into threads, in the order the threads were started. The API returns
+// review comments individually; a reply is attached to its thread by
+// following InReplyTo up to the comment that started it. A reply to a
+// comment that no longer exists starts a thread of its own.
+//
+// Comments are listed 100 per request, so this costs one request per 100
+// review comments against the rate limit. If the pages are capped with
+// Client.WithMaxPages, the comments listed are grouped and returned along
+// with ErrMaxPagesExceeded.
+func (s *PullRequestsService) ListReviewThreads(ctx context.Context, owner, repo string, number int) ([]*ReviewThread, *Response, error) {
+	opts := &PullRequestListCommentsOptions{
+		Sort:        "created",
+		Direction:   "asc",
+		ListOptions: ListOptions{PerPage: 100},
+	}
+	var comments []*PullRequestComment
+	resp, err := paginate(s.client.maxPages, &opts.ListOptions, func() (*Response, error) {
+		page, resp, err := s.ListComments(ctx, owner, repo, number, opts)
+		comments = append(comments, page...)
+		return resp, err
+	})
+	if err != nil && err != ErrMaxPagesExceeded {
+		return nil, resp, err
+	}
+
+	byID := make(map[int64]*PullRequestComment, len(comments))
+	for _, c := range comments {
+		byID[c.GetID()] = c
+	}
+	root := func(c *PullRequestComment) *PullRequestComment {
+		seen := map[int64]bool{c.GetID(): true}
+		for {
+			parent, ok := byID[c.GetInReplyTo()]
+			if c.InReplyTo == nil || !ok || seen[parent.GetID()] {
+				return c
+			}
+			seen[parent.GetID()] = true
+			c = parent
+		}
+	}
+
+	var threads []*ReviewThread
+	byRoot := make(map[int64]*ReviewThread)
+	for _, c := range comments {
+		r := root(c)
+		t, ok := byRoot[r.GetID()]
+		if !ok {
+			t = &ReviewThread{Root: r}
+			byRoot[r.GetID()] = t
+			threads = append(threads, t)
+		}
+		if c != r {
+			t.Replies = append(t.Replies, c)
+		}
+	}
+
+	return threads, resp, err
+}
+
 // GetComment fetches the specified pull request comment.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#get-a-review-comment-for-a-pull-request
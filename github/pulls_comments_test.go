@@ -188,6 +188,52 @@ func TestPullRequestsService_ListComments_specificPull(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_ListComments_specificPullSince(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"sort":      "created",
+			"direction": "asc",
+			"since":     "2002-02-10T15:30:00+02:00",
+		})
+		fmt.Fprint(w, `[{"id":2}]`)
+	})
+
+	opt := &PullRequestListCommentsOptions{
+		Sort:      "created",
+		Direction: "asc",
+		Since:     time.Date(2002, time.February, 10, 15, 30, 0, 0, time.FixedZone("", 2*60*60)),
+	}
+	pulls, _, err := client.PullRequests.ListComments(context.Background(), "o", "r", 1, opt)
+	if err != nil {
+		t.Errorf("PullRequests.ListComments returned error: %v", err)
+	}
+
+	want := []*PullRequestComment{{ID: Int64(2)}}
+	if !reflect.DeepEqual(pulls, want) {
+		t.Errorf("PullRequests.ListComments returned %+v, want %+v", pulls, want)
+	}
+}
+
+func TestPullRequestsService_ListComments_zeroSinceOmitted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sort": "updated"})
+		fmt.Fprint(w, `[]`)
+	})
+
+	opt := &PullRequestListCommentsOptions{Sort: "updated"}
+	if _, _, err := client.PullRequests.ListComments(context.Background(), "o", "r", 0, opt); err != nil {
+		t.Errorf("PullRequests.ListComments returned error: %v", err)
+	}
+}
+
 func TestPullRequestsService_ListComments_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -196,6 +242,47 @@ func TestPullRequestsService_ListComments_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestPullRequestsService_ListReviewThreads(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			testFormValues(t, r, values{"sort": "created", "direction": "asc", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/comments?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2},{"id":3,"in_reply_to_id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":4,"in_reply_to_id":2},{"id":5,"in_reply_to_id":3},{"id":6,"in_reply_to_id":99}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	threads, _, err := client.PullRequests.ListReviewThreads(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.ListReviewThreads returned error: %v", err)
+	}
+
+	comment := func(id int64, inReplyTo int64) *PullRequestComment {
+		c := &PullRequestComment{ID: Int64(id)}
+		if inReplyTo != 0 {
+			c.InReplyTo = Int64(inReplyTo)
+		}
+		return c
+	}
+	want := []*ReviewThread{
+		{Root: comment(1, 0), Replies: []*PullRequestComment{comment(3, 1), comment(5, 3)}},
+		{Root: comment(2, 0), Replies: []*PullRequestComment{comment(4, 2)}},
+		// The comment replied to was deleted.
+		{Root: comment(6, 99)},
+	}
+	if !reflect.DeepEqual(threads, want) {
+		t.Errorf("PullRequests.ListReviewThreads returned %+v, want %+v", threads, want)
+	}
+}
+
 func TestPullRequestsService_GetComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
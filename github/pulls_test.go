@@ -103,6 +103,114 @@ func TestPullRequestsService_Get(t *testing.T) {
 	}
 }
 
+func TestPullRequestsService_Get_crossFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"number": 1,
+			"head": {
+				"label": "f:feature",
+				"ref": "feature",
+				"sha": "h",
+				"user": {"login": "f"},
+				"repo": {"id": 2, "name": "r2", "full_name": "f/r2", "fork": true, "owner": {"login": "f"}, "clone_url": "https://github.com/f/r2.git"}
+			},
+			"base": {
+				"label": "o:main",
+				"ref": "main",
+				"sha": "b",
+				"user": {"login": "o"},
+				"repo": {"id": 1, "name": "r", "full_name": "o/r", "owner": {"login": "o"}, "clone_url": "https://github.com/o/r.git"}
+			}
+		}`)
+	})
+
+	pull, _, err := client.PullRequests.Get(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.Get returned error: %v", err)
+	}
+
+	wantHead := &PullRequestBranch{
+		Label: String("f:feature"),
+		Ref:   String("feature"),
+		SHA:   String("h"),
+		User:  &User{Login: String("f")},
+		Repo: &Repository{
+			ID:       Int64(2),
+			Name:     String("r2"),
+			FullName: String("f/r2"),
+			Fork:     Bool(true),
+			Owner:    &User{Login: String("f")},
+			CloneURL: String("https://github.com/f/r2.git"),
+		},
+	}
+	if !reflect.DeepEqual(pull.Head, wantHead) {
+		t.Errorf("PullRequests.Get returned head %+v, want %+v", pull.Head, wantHead)
+	}
+
+	owner, repo, ref, isFork := PullRequestHeadRepo(pull)
+	if owner != "f" || repo != "r2" || ref != "feature" || !isFork {
+		t.Errorf("PullRequestHeadRepo returned (%q, %q, %q, %v), want (%q, %q, %q, %v)", owner, repo, ref, isFork, "f", "r2", "feature", true)
+	}
+}
+
+func TestPullRequestHeadRepo(t *testing.T) {
+	baseRepo := &Repository{ID: Int64(1), Name: String("r"), FullName: String("o/r"), Owner: &User{Login: String("o")}}
+	tests := []struct {
+		name       string
+		pr         *PullRequest
+		wantOwner  string
+		wantRepo   string
+		wantRef    string
+		wantIsFork bool
+	}{
+		{
+			name: "same repository",
+			pr: &PullRequest{
+				Head: &PullRequestBranch{Ref: String("feature"), Repo: baseRepo},
+				Base: &PullRequestBranch{Ref: String("main"), Repo: baseRepo},
+			},
+			wantOwner: "o",
+			wantRepo:  "r",
+			wantRef:   "feature",
+		},
+		{
+			name: "fork identified by full name only",
+			pr: &PullRequest{
+				Head: &PullRequestBranch{Ref: String("fix"), Repo: &Repository{FullName: String("f/r")}},
+				Base: &PullRequestBranch{Ref: String("main"), Repo: &Repository{FullName: String("o/r")}},
+			},
+			wantOwner:  "f",
+			wantRepo:   "r",
+			wantRef:    "fix",
+			wantIsFork: true,
+		},
+		{
+			name: "deleted head repository",
+			pr: &PullRequest{
+				Head: &PullRequestBranch{Ref: String("fix"), User: &User{Login: String("f")}},
+				Base: &PullRequestBranch{Ref: String("main"), Repo: baseRepo},
+			},
+			wantOwner:  "f",
+			wantRef:    "fix",
+			wantIsFork: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, repo, ref, isFork := PullRequestHeadRepo(tt.pr)
+			if owner != tt.wantOwner || repo != tt.wantRepo || ref != tt.wantRef || isFork != tt.wantIsFork {
+				t.Errorf("PullRequestHeadRepo returned (%q, %q, %q, %v), want (%q, %q, %q, %v)",
+					owner, repo, ref, isFork, tt.wantOwner, tt.wantRepo, tt.wantRef, tt.wantIsFork)
+			}
+		})
+	}
+}
+
 func TestPullRequestsService_GetRaw_diff(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,263 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecordedInteraction is an HTTP request and its response, as stored in the
+// file written by Client.WithRecording and read by Client.WithReplay.
+type RecordedInteraction struct {
+	// Key identifies the request: its method, URL and the SHA-256 hash of
+	// its body. Requests are matched on it when replaying.
+	Key    string `json:"key"`
+	Method string `json:"method"`
+	URL    string `json:"url"`
+
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	// Body is the response body when it is valid UTF-8; otherwise it is
+	// stored base64-encoded in BodyBase64.
+	Body       string `json:"body,omitempty"`
+	BodyBase64 string `json:"body_base64,omitempty"`
+}
+
+// scrubbedResponseHeaders are response headers never written to recordings.
+var scrubbedResponseHeaders = []string{"Set-Cookie"}
+
+// scrubbedQueryParams are query parameters whose value is redacted from the
+// URLs and keys of recorded interactions.
+var scrubbedQueryParams = []string{"access_token", "client_secret"}
+
+// scrubLocation redacts the values of all the query parameters of the
+// Location header of a recorded response, since redirects to storage hosts
+// carry signed URLs. The header is dropped if loc can't be parsed.
+func scrubLocation(header http.Header) {
+	loc := header.Get("Location")
+	if loc == "" {
+		return
+	}
+	u, err := url.Parse(loc)
+	if err != nil {
+		header.Del("Location")
+		return
+	}
+	q := u.Query()
+	for p := range q {
+		q.Set(p, "REDACTED")
+	}
+	u.RawQuery = q.Encode()
+	header.Set("Location", u.String())
+}
+
+// WithRecording returns a copy of c (see Clone) that records the requests it
+// makes and their responses to the file path, for later use with
+// WithReplay. Requests are sent through the transport of c as usual. The
+// file is rewritten after every response, so it is complete even if the
+// program stops early; an existing file is overwritten.
+//
+// Request headers are not recorded, so credentials such as the
+// Authorization header never reach the file, and the Set-Cookie response
+// header, the query parameters of the Location response header and the
+// access_token and client_secret query parameters are scrubbed. Response
+// bodies are recorded as is: responses that carry secrets, such as
+// installation access tokens, must be redacted by hand. The file is only
+// readable by its owner.
+func (c *Client) WithRecording(path string) *Client {
+	c2 := c.Clone()
+	c2.client.Transport = &recordingTransport{
+		path:      path,
+		Transport: c2.client.Transport,
+	}
+	return c2
+}
+
+// WithReplay returns a copy of c (see Clone) that answers requests with the
+// responses recorded by WithRecording in the file path, without sending
+// them. Requests are matched by method, URL and body. When the same request
+// was recorded several times, its responses are replayed in the order they
+// were recorded, the last one being repeated once the others are used up.
+//
+// A request without a recorded response fails with an error, wrapped in a
+// *url.Error.
+func (c *Client) WithReplay(path string) (*Client, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var interactions []*RecordedInteraction
+	if err := json.Unmarshal(data, &interactions); err != nil {
+		return nil, fmt.Errorf("parsing recording %v: %w", path, err)
+	}
+
+	t := &replayTransport{responses: make(map[string][]*RecordedInteraction)}
+	for _, in := range interactions {
+		t.responses[in.Key] = append(t.responses[in.Key], in)
+	}
+
+	c2 := c.Clone()
+	c2.client.Transport = t
+	return c2, nil
+}
+
+// interactionKey returns the key identifying req among recorded
+// interactions, along with its scrubbed URL.
+func interactionKey(req *http.Request, body []byte) (key, u string) {
+	redacted := *req.URL
+	q := redacted.Query()
+	for _, p := range scrubbedQueryParams {
+		if q.Get(p) != "" {
+			q.Set(p, "REDACTED")
+		}
+	}
+	redacted.RawQuery = q.Encode()
+	u = redacted.String()
+
+	sum := sha256.Sum256(body)
+	return req.Method + " " + u + " " + hex.EncodeToString(sum[:]), u
+}
+
+// readRequestBody reads and closes the body of req, if any.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return ioutil.ReadAll(req.Body)
+}
+
+// recordingTransport is an http.RoundTripper that records requests and their
+// responses to a file. It is used by Client.WithRecording.
+type recordingTransport struct {
+	path string
+
+	mu           sync.Mutex
+	interactions []*RecordedInteraction
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	req2 := req.Clone(req.Context())
+	if req.Body != nil {
+		req2.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.transport().RoundTrip(req2)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	key, u := interactionKey(req, reqBody)
+	in := &RecordedInteraction{
+		Key:        key,
+		Method:     req.Method,
+		URL:        u,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+	}
+	for _, h := range scrubbedResponseHeaders {
+		in.Header.Del(h)
+	}
+	scrubLocation(in.Header)
+	if utf8.Valid(respBody) {
+		in.Body = string(respBody)
+	} else {
+		in.BodyBase64 = base64.StdEncoding.EncodeToString(respBody)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interactions = append(t.interactions, in)
+	data, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err == nil {
+		err = ioutil.WriteFile(t.path, data, 0600)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("recording %v %v: %w", req.Method, u, err)
+	}
+	return resp, nil
+}
+
+func (t *recordingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// replayTransport is an http.RoundTripper that answers requests with
+// recorded responses. It is used by Client.WithReplay.
+type replayTransport struct {
+	mu        sync.Mutex
+	responses map[string][]*RecordedInteraction
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key, u := interactionKey(req, body)
+
+	t.mu.Lock()
+	queue := t.responses[key]
+	if len(queue) > 1 {
+		t.responses[key] = queue[1:]
+	}
+	t.mu.Unlock()
+	if len(queue) == 0 {
+		return nil, fmt.Errorf("no recorded response for %v %v", req.Method, u)
+	}
+	in := queue[0]
+
+	respBody := []byte(in.Body)
+	if in.BodyBase64 != "" {
+		if respBody, err = base64.StdEncoding.DecodeString(in.BodyBase64); err != nil {
+			return nil, fmt.Errorf("decoding recorded response for %v %v: %w", req.Method, u, err)
+		}
+	}
+	header := in.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestClient_WithRecording_replay(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	gets := 0
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		gets++
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Location", "https://storage.example.com/archive.zip?sig=signed-secret")
+		fmt.Fprintf(w, `{"id":%d}`, gets)
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"t"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"number":7}`)
+	})
+
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recording.json")
+
+	ctx := context.Background()
+	recorder := client.WithAuthToken("secret-token").WithRecording(path)
+	for i := 0; i < 2; i++ {
+		if _, _, err := recorder.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	if _, _, err := recorder.Issues.Create(ctx, "o", "r", &IssueRequest{Title: String("t")}); err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-token", "session=secret", "signed-secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("recording contains %q", secret)
+		}
+	}
+	if fi, err := os.Stat(path); err != nil {
+		t.Fatal(err)
+	} else if runtime.GOOS != "windows" && fi.Mode().Perm()&0077 != 0 {
+		t.Errorf("recording has mode %v, want it readable by its owner only", fi.Mode().Perm())
+	}
+
+	// The replaying client must not reach the server.
+	gets = 100
+	replayer, err := client.WithReplay(path)
+	if err != nil {
+		t.Fatalf("WithReplay returned error: %v", err)
+	}
+	for _, want := range []int64{1, 2, 2} {
+		repo, _, err := replayer.Repositories.Get(ctx, "o", "r")
+		if err != nil {
+			t.Fatalf("replayed Repositories.Get returned error: %v", err)
+		}
+		if repo.GetID() != want {
+			t.Errorf("replayed Repositories.Get returned ID %v, want %v", repo.GetID(), want)
+		}
+	}
+	issue, resp, err := replayer.Issues.Create(ctx, "o", "r", &IssueRequest{Title: String("t")})
+	if err != nil {
+		t.Fatalf("replayed Issues.Create returned error: %v", err)
+	}
+	if issue.GetNumber() != 7 || resp.StatusCode != http.StatusCreated {
+		t.Errorf("replayed Issues.Create returned issue %v with status %v, want issue 7 with status 201", issue.GetNumber(), resp.StatusCode)
+	}
+
+	// A request with another body wasn't recorded.
+	if _, _, err := replayer.Issues.Create(ctx, "o", "r", &IssueRequest{Title: String("other")}); err == nil {
+		t.Error("replayed Issues.Create with an unrecorded body returned nil error, want an error")
+	}
+	if gets != 100 {
+		t.Error("replaying client sent requests to the server")
+	}
+}
+
+func TestClient_WithReplay_missingFile(t *testing.T) {
+	if _, err := NewClient(nil).WithReplay(filepath.Join(os.TempDir(), "does-not-exist.json")); err == nil {
+		t.Error("WithReplay returned nil error, want an error")
+	}
+}
@@ -8,10 +8,16 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
+// ErrEmptyRepository is returned by RepositoriesService.GetDefaultBranch for
+// repositories without any commits, whose default branch doesn't exist yet.
+var ErrEmptyRepository = errors.New("repository is empty")
+
 // RepositoriesService handles communication with the repository related
 // methods of the GitHub API.
 //
@@ -290,6 +296,29 @@ func (s *RepositoriesService) ListAll(ctx context.Context, opts *RepositoryListA
 	return repos, resp, nil
 }
 
+// ListAllFunc lists all public GitHub repositories, calling fn with each page
+// of repositories in the order they were created. Each page is requested with
+// the ID of the last repository seen, starting after opts.Since; opts.PerPage
+// is not supported by this endpoint. Iteration stops when there are no more
+// repositories, or when fn returns an error, which is then returned.
+func (s *RepositoriesService) ListAllFunc(ctx context.Context, opts *ListSinceOptions, fn func([]*Repository) error) (*Response, error) {
+	o := new(ListSinceOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	return paginateSince(s.client.maxPages, o, func() (int64, *Response, error) {
+		repos, resp, err := s.ListAll(ctx, &RepositoryListAllOptions{Since: o.Since})
+		if err != nil || len(repos) == 0 {
+			return 0, resp, err
+		}
+		if err := fn(repos); err != nil {
+			return 0, resp, err
+		}
+		return repos[len(repos)-1].GetID(), resp, nil
+	})
+}
+
 // createRepoRequest is a subset of Repository and is used internally
 // by Create to pass only the known fields for the endpoint.
 //
@@ -438,6 +467,61 @@ func (s *RepositoriesService) Get(ctx context.Context, owner, repo string) (*Rep
 	return repository, resp, nil
 }
 
+// EstimateCloneSize returns an estimate, in bytes, of the disk space a clone
+// of a repository takes, based on the Size field of the repository.
+//
+// Size is the only size information the API returns: the disk usage of the
+// repository on GitHub, in kilobytes. It is recalculated periodically rather
+// than on every push, doesn't include Git LFS objects or the working tree
+// checked out by a clone, and the packfile a clone downloads may be smaller
+// or larger, so the estimate is only suitable for budgeting disk space.
+func (s *RepositoriesService) EstimateCloneSize(ctx context.Context, owner, repo string) (int64, *Response, error) {
+	r, resp, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return 0, resp, err
+	}
+	return int64(r.GetSize()) * 1024, resp, nil
+}
+
+// GetRepositories fetches the given repositories, identified by their full
+// name in the form "owner/repo", which is also used as the key of the
+// returned map.
+//
+// One Get request is made per repository, with a bounded number of requests
+// in flight at once, so each repository counts against the rate limit. The
+// names of repositories that don't exist, or that the caller can't see, are
+// returned in notFound, in the order they were given, rather than as
+// errors. If any other request fails, the repositories fetched successfully
+// are returned along with a *BatchError describing the failures.
+func (s *RepositoriesService) GetRepositories(ctx context.Context, fullNames []string) (repos map[string]*Repository, notFound []string, err error) {
+	found := make([]*Repository, len(fullNames))
+	missing := make([]bool, len(fullNames))
+	err = forEachConcurrently(ctx, fullNames, func(i int) error {
+		owner, name, err := splitFullName(fullNames[i])
+		if err != nil {
+			return err
+		}
+		repo, _, err := s.Get(ctx, owner, name)
+		if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+			missing[i] = true
+			return nil
+		}
+		found[i] = repo
+		return err
+	})
+
+	repos = make(map[string]*Repository, len(fullNames))
+	for i, name := range fullNames {
+		switch {
+		case missing[i]:
+			notFound = append(notFound, name)
+		case found[i] != nil:
+			repos[name] = found[i]
+		}
+	}
+	return repos, notFound, err
+}
+
 // GetCodeOfConduct gets the contents of a repository's code of conduct.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/codes-of-conduct/#get-the-code-of-conduct-for-a-repository
@@ -739,11 +823,53 @@ func (s *RepositoriesService) ListTags(ctx context.Context, owner string, repo s
 	return tags, resp, nil
 }
 
+// ListTagsAll lists all tags for the specified repository, following
+// pagination until every page has been fetched. opts.Page is used as the
+// first page to fetch. Use SortTagsSemver to order the tags by version.
+//
+// If a request fails, the tags fetched so far are returned along with the
+// error.
+func (s *RepositoriesService) ListTagsAll(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*RepositoryTag, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*RepositoryTag
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		tags, resp, err := s.ListTags(ctx, owner, repo, o)
+		all = append(all, tags...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // Branch represents a repository branch
 type Branch struct {
 	Name      *string           `json:"name,omitempty"`
 	Commit    *RepositoryCommit `json:"commit,omitempty"`
 	Protected *bool             `json:"protected,omitempty"`
+
+	// Protection summarizes the protection of the branch. The full
+	// protection settings are available from GetBranchProtection.
+	Protection    *BranchProtectionSummary `json:"protection,omitempty"`
+	ProtectionURL *string                  `json:"protection_url,omitempty"`
+}
+
+// BranchProtectionSummary summarizes the protection of a branch, as included
+// in a Branch.
+type BranchProtectionSummary struct {
+	Enabled              *bool                      `json:"enabled,omitempty"`
+	RequiredStatusChecks *BranchStatusChecksSummary `json:"required_status_checks,omitempty"`
+}
+
+// BranchStatusChecksSummary summarizes the required status checks of a
+// protected branch.
+type BranchStatusChecksSummary struct {
+	// EnforcementLevel is the set of users to whom the required status checks
+	// apply. Possible values are: off, non_admins, everyone.
+	EnforcementLevel *string  `json:"enforcement_level,omitempty"`
+	Contexts         []string `json:"contexts,omitempty"`
 }
 
 // Protection represents a repository branch's protection.
@@ -778,12 +904,28 @@ type RequiredStatusChecks struct {
 	// The list of status checks to require in order to merge into this
 	// branch. (Required; use []string{} instead of nil for empty list.)
 	Contexts []string `json:"contexts"`
+	// The list of status checks to require in order to merge into this
+	// branch, along with the app expected to set each of them. It supersedes
+	// Contexts, which should be left empty when Checks is set.
+	Checks []*RequiredStatusCheck `json:"checks,omitempty"`
+}
+
+// RequiredStatusCheck represents a status check required on a protected branch.
+type RequiredStatusCheck struct {
+	// The name of the required check.
+	Context string `json:"context"`
+	// The ID of the GitHub App that must set the status in order for it
+	// to be accepted. A nil AppID accepts the status from any app, and
+	// an AppID of -1 accepts it from any source.
+	AppID *int64 `json:"app_id,omitempty"`
 }
 
 // RequiredStatusChecksRequest represents a request to edit a protected branch's status checks.
 type RequiredStatusChecksRequest struct {
 	Strict   *bool    `json:"strict,omitempty"`
 	Contexts []string `json:"contexts,omitempty"`
+	// Checks supersedes Contexts; only one of them should be set.
+	Checks []*RequiredStatusCheck `json:"checks,omitempty"`
 }
 
 // PullRequestReviewsEnforcement represents the pull request reviews enforcement of a protected branch.
@@ -930,6 +1072,27 @@ func (s *RepositoriesService) ListBranches(ctx context.Context, owner string, re
 	return branches, resp, nil
 }
 
+// ListBranchesAll lists all the branches for the specified repository,
+// following pagination until every page has been fetched. opts.Page is used
+// as the first page to fetch.
+//
+// If a request fails, the branches fetched so far are returned along with
+// the error.
+func (s *RepositoriesService) ListBranchesAll(ctx context.Context, owner string, repo string, opts *BranchListOptions) ([]*Branch, *Response, error) {
+	o := new(BranchListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Branch
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		branches, resp, err := s.ListBranches(ctx, owner, repo, o)
+		all = append(all, branches...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetBranch gets the specified branch for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-branch
@@ -952,6 +1115,36 @@ func (s *RepositoriesService) GetBranch(ctx context.Context, owner, repo, branch
 	return b, resp, nil
 }
 
+// GetDefaultBranch gets the default branch of a repository.
+//
+// A freshly created repository reports a default branch that doesn't exist
+// until the first commit is pushed, and most branch and commit endpoints then
+// fail with 404 Not Found. GetDefaultBranch detects this case and returns
+// ErrEmptyRepository.
+func (s *RepositoriesService) GetDefaultBranch(ctx context.Context, owner, repo string) (*Branch, *Response, error) {
+	r, resp, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	b, resp, err := s.GetBranch(ctx, owner, repo, r.GetDefaultBranch())
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+		// Listing the commits of an empty repository fails with 409 Conflict.
+		_, commitsResp, commitsErr := s.ListCommits(ctx, owner, repo, &CommitsListOptions{ListOptions: ListOptions{PerPage: 1}})
+		if commitsResp != nil && commitsResp.StatusCode == http.StatusConflict {
+			return nil, commitsResp, ErrEmptyRepository
+		}
+		if commitsErr != nil {
+			return nil, commitsResp, commitsErr
+		}
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
 // GetBranchProtection gets the protection of a given branch.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-branch-protection
@@ -1017,6 +1210,42 @@ func (s *RepositoriesService) ListRequiredStatusChecksContexts(ctx context.Conte
 	return contexts, resp, nil
 }
 
+// AddRequiredStatusChecksContexts adds contexts to the required status checks
+// of a given protected branch, leaving the existing ones in place. It returns
+// the resulting list of contexts.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#add-status-check-contexts
+func (s *RepositoriesService) AddRequiredStatusChecksContexts(ctx context.Context, owner, repo, branch string, contexts []string) ([]string, *Response, error) {
+	return s.editRequiredStatusChecksContexts(ctx, "POST", owner, repo, branch, contexts)
+}
+
+// RemoveRequiredStatusChecksContexts removes contexts from the required status
+// checks of a given protected branch. It returns the remaining list of contexts.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branch-protection#remove-status-check-contexts
+func (s *RepositoriesService) RemoveRequiredStatusChecksContexts(ctx context.Context, owner, repo, branch string, contexts []string) ([]string, *Response, error) {
+	return s.editRequiredStatusChecksContexts(ctx, "DELETE", owner, repo, branch, contexts)
+}
+
+func (s *RepositoriesService) editRequiredStatusChecksContexts(ctx context.Context, method, owner, repo, branch string, contexts []string) ([]string, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/protection/required_status_checks/contexts", owner, repo, branch)
+	body := &struct {
+		Contexts []string `json:"contexts"`
+	}{contexts}
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result []string
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
 // UpdateBranchProtection updates the protection of a given branch.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-branch-protection
@@ -1039,6 +1268,41 @@ func (s *RepositoriesService) UpdateBranchProtection(ctx context.Context, owner,
 	return p, resp, nil
 }
 
+// ApplyDefaultBranchProtection applies the same branch protection template to
+// the default branch of each of the given repositories, identified by their
+// full name in the form "owner/repo", which is also used as the key of the
+// returned map of resulting protections. template is sent as is, so it must
+// be a complete ProtectionRequest; it is not modified.
+//
+// Two requests are made per repository, one to find its default branch and
+// one to update its protection, with a bounded number of repositories
+// processed at once. If some repositories can't be protected, the
+// protections applied successfully are returned along with a *BatchError
+// describing the failures.
+func (s *RepositoriesService) ApplyDefaultBranchProtection(ctx context.Context, fullNames []string, template *ProtectionRequest) (map[string]*Protection, error) {
+	protections := make([]*Protection, len(fullNames))
+	err := forEachConcurrently(ctx, fullNames, func(i int) error {
+		owner, name, err := splitFullName(fullNames[i])
+		if err != nil {
+			return err
+		}
+		repo, _, err := s.Get(ctx, owner, name)
+		if err != nil {
+			return err
+		}
+		protections[i], _, err = s.UpdateBranchProtection(ctx, owner, name, repo.GetDefaultBranch(), template)
+		return err
+	})
+
+	result := make(map[string]*Protection, len(fullNames))
+	for i, name := range fullNames {
+		if protections[i] != nil {
+			result[name] = protections[i]
+		}
+	}
+	return result, err
+}
+
 // RemoveBranchProtection removes the protection of a given branch.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#delete-branch-protection
@@ -0,0 +1,102 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ActionsPermissionsRepository represents the GitHub Actions permissions
+// policy of a repository.
+type ActionsPermissionsRepository struct {
+	// Enabled is whether GitHub Actions is enabled on the repository.
+	Enabled *bool `json:"enabled,omitempty"`
+	// AllowedActions is the policy that controls the actions that are
+	// allowed to run. Possible values are: all, local_only, selected.
+	AllowedActions *string `json:"allowed_actions,omitempty"`
+	// SelectedActionsURL is read-only; it is ignored when editing the
+	// permissions.
+	SelectedActionsURL *string `json:"selected_actions_url,omitempty"`
+}
+
+func (a ActionsPermissionsRepository) String() string {
+	return Stringify(a)
+}
+
+// GetActionsPermissions gets the GitHub Actions permissions policy for a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/permissions#get-github-actions-permissions-for-a-repository
+func (s *RepositoriesService) GetActionsPermissions(ctx context.Context, owner, repo string) (*ActionsPermissionsRepository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions", owner, repo)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(ActionsPermissionsRepository)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditActionsPermissions sets the GitHub Actions permissions policy for a
+// repository. Enabled is required. Since the whole policy is replaced, use
+// EditActionsPermissionsPreservingUnset to change only some of the fields.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/permissions#set-github-actions-permissions-for-a-repository
+func (s *RepositoriesService) EditActionsPermissions(ctx context.Context, owner, repo string, permissions *ActionsPermissionsRepository) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/permissions", owner, repo)
+	body := &ActionsPermissionsRepository{
+		Enabled:        permissions.Enabled,
+		AllowedActions: permissions.AllowedActions,
+	}
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// EditActionsPermissionsPreservingUnset changes only the non-nil fields of
+// changes in the GitHub Actions permissions policy of a repository, keeping
+// the current value of the others. This lets callers toggle Enabled without
+// resetting AllowedActions, for instance. The current policy is fetched with
+// GetActionsPermissions, so two requests are made, and the resulting policy
+// is returned.
+//
+// The fetch and the update are not atomic: a change made by someone else in
+// between is overwritten.
+func (s *RepositoriesService) EditActionsPermissionsPreservingUnset(ctx context.Context, owner, repo string, changes *ActionsPermissionsRepository) (*ActionsPermissionsRepository, *Response, error) {
+	current, resp, err := s.GetActionsPermissions(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	merged := &ActionsPermissionsRepository{
+		Enabled:            current.Enabled,
+		AllowedActions:     current.AllowedActions,
+		SelectedActionsURL: current.SelectedActionsURL,
+	}
+	if changes.Enabled != nil {
+		merged.Enabled = changes.Enabled
+	}
+	if changes.AllowedActions != nil {
+		merged.AllowedActions = changes.AllowedActions
+	}
+
+	resp, err = s.EditActionsPermissions(ctx, owner, repo, merged)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return merged, resp, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_GetActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled":true,"allowed_actions":"selected","selected_actions_url":"https://api.github.com/repos/o/r/actions/permissions/selected-actions"}`)
+	})
+
+	permissions, _, err := client.Repositories.GetActionsPermissions(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetActionsPermissions returned error: %v", err)
+	}
+
+	want := &ActionsPermissionsRepository{
+		Enabled:            Bool(true),
+		AllowedActions:     String("selected"),
+		SelectedActionsURL: String("https://api.github.com/repos/o/r/actions/permissions/selected-actions"),
+	}
+	if !reflect.DeepEqual(permissions, want) {
+		t.Errorf("Repositories.GetActionsPermissions returned %+v, want %+v", permissions, want)
+	}
+}
+
+func TestRepositoriesService_EditActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":true,"allowed_actions":"local_only"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	input := &ActionsPermissionsRepository{
+		Enabled:            Bool(true),
+		AllowedActions:     String("local_only"),
+		SelectedActionsURL: String("u"),
+	}
+	_, err := client.Repositories.EditActionsPermissions(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.EditActionsPermissions returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_EditActionsPermissionsPreservingUnset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"enabled":true,"allowed_actions":"selected","selected_actions_url":"u"}`)
+		case "PUT":
+			testBody(t, r, `{"enabled":false,"allowed_actions":"selected"}`+"\n")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %v", r.Method)
+		}
+	})
+
+	input := &ActionsPermissionsRepository{Enabled: Bool(false)}
+	permissions, _, err := client.Repositories.EditActionsPermissionsPreservingUnset(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.EditActionsPermissionsPreservingUnset returned error: %v", err)
+	}
+
+	want := &ActionsPermissionsRepository{
+		Enabled:            Bool(false),
+		AllowedActions:     String("selected"),
+		SelectedActionsURL: String("u"),
+	}
+	if !reflect.DeepEqual(permissions, want) {
+		t.Errorf("Repositories.EditActionsPermissionsPreservingUnset returned %+v, want %+v", permissions, want)
+	}
+}
+
+func TestRepositoriesService_EditActionsPermissionsPreservingUnset_getError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("policy edited despite failing to fetch it")
+		}
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, _, err := client.Repositories.EditActionsPermissionsPreservingUnset(context.Background(), "o", "r", &ActionsPermissionsRepository{Enabled: Bool(true)})
+	if err == nil {
+		t.Error("Repositories.EditActionsPermissionsPreservingUnset returned nil error, want an error")
+	}
+}
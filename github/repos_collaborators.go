@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // ListCollaboratorsOptions specifies the optional parameters to the
@@ -152,3 +153,95 @@ func (s *RepositoriesService) RemoveCollaborator(ctx context.Context, owner, rep
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// CollaboratorPermissionChange represents a change to who has access to a
+// repository, as reconstructed by ListCollaboratorPermissionChanges.
+type CollaboratorPermissionChange struct {
+	// Action is one of: "added", "removed", "edited" for collaborators, or
+	// "team_added" for teams.
+	Action string
+
+	// User is the collaborator whose access changed. It is nil for teams.
+	User *User
+	// Team is the team given access. It is nil for collaborators.
+	Team *Team
+
+	// Permission is the permission after the change, and PreviousPermission
+	// the permission before it. Either is empty when the event does not
+	// report it.
+	Permission         string
+	PreviousPermission string
+
+	// Actor is the user who made the change.
+	Actor     *User
+	CreatedAt *time.Time
+}
+
+// ListCollaboratorPermissionChanges reconstructs the changes to the
+// collaborators and teams with access to a repository from its events,
+// newest first. All the pages of ActivityService.ListRepositoryEvents are
+// requested.
+//
+// The events API only returns events of the past 90 days, and no more than
+// 300 of them, and timelines mostly report additions rather than removals or
+// permission edits. Older or complete history requires the audit log of the
+// organization (see OrganizationsService.GetAuditLog).
+func (s *RepositoriesService) ListCollaboratorPermissionChanges(ctx context.Context, owner, repo string) ([]*CollaboratorPermissionChange, *Response, error) {
+	var changes []*CollaboratorPermissionChange
+	opts := &ListOptions{PerPage: 100}
+	resp, err := paginate(s.client.maxPages, opts, func() (*Response, error) {
+		events, resp, err := s.client.Activity.ListRepositoryEvents(ctx, owner, repo, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, e := range events {
+			change, err := collaboratorPermissionChange(e)
+			if err != nil {
+				return resp, err
+			}
+			if change != nil {
+				changes = append(changes, change)
+			}
+		}
+		return resp, nil
+	})
+	if err != nil {
+		return changes, resp, err
+	}
+
+	return changes, resp, nil
+}
+
+// collaboratorPermissionChange returns the change described by e, or nil
+// if e does not change the access to the repository.
+func collaboratorPermissionChange(e *Event) (*CollaboratorPermissionChange, error) {
+	switch e.GetType() {
+	case "MemberEvent", "TeamAddEvent":
+	default:
+		return nil, nil
+	}
+	payload, err := e.ParsePayload()
+	if err != nil {
+		return nil, err
+	}
+
+	change := &CollaboratorPermissionChange{Actor: e.Actor, CreatedAt: e.CreatedAt}
+	switch p := payload.(type) {
+	case *MemberEvent:
+		change.Action = p.GetAction()
+		change.User = p.Member
+		if p.Changes != nil && p.Changes.Permission != nil {
+			if to := p.Changes.Permission.To; to != nil {
+				change.Permission = *to
+			}
+			if from := p.Changes.Permission.From; from != nil {
+				change.PreviousPermission = *from
+			}
+		}
+	case *TeamAddEvent:
+		change.Action = "team_added"
+		change.Team = p.Team
+		change.Permission = p.GetTeam().GetPermission()
+	}
+	return change, nil
+}
@@ -215,3 +215,42 @@ func TestRepositoriesService_RemoveCollaborator_invalidUser(t *testing.T) {
 	_, err := client.Repositories.RemoveCollaborator(context.Background(), "%", "%", "%")
 	testURLParseError(t, err)
 }
+
+func TestRepositoriesService_ListCollaboratorPermissionChanges(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":"3","type":"MemberEvent","actor":{"login":"admin"},"created_at":`+referenceTimeStr+`,"payload":{"action":"edited","member":{"login":"u"},"changes":{"permission":{"from":"write","to":"admin"}}}},
+				{"id":"2","type":"PushEvent","payload":{}}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"id":"1","type":"MemberEvent","actor":{"login":"admin"},"payload":{"action":"added","member":{"login":"u"}}},
+				{"id":"0","type":"TeamAddEvent","actor":{"login":"admin"},"payload":{"team":{"slug":"t","permission":"pull"}}}
+			]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	changes, _, err := client.Repositories.ListCollaboratorPermissionChanges(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ListCollaboratorPermissionChanges returned error: %v", err)
+	}
+
+	admin := &User{Login: String("admin")}
+	want := []*CollaboratorPermissionChange{
+		{Action: "edited", User: &User{Login: String("u")}, Permission: "admin", PreviousPermission: "write", Actor: admin, CreatedAt: &referenceTime},
+		{Action: "added", User: &User{Login: String("u")}, Actor: admin},
+		{Action: "team_added", Team: &Team{Slug: String("t"), Permission: String("pull")}, Permission: "pull", Actor: admin},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Repositories.ListCollaboratorPermissionChanges returned %+v, want %+v", changes, want)
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -88,6 +89,52 @@ func (s *RepositoriesService) ListCommitComments(ctx context.Context, owner, rep
 	return comments, resp, nil
 }
 
+// ListCommentsForCommitRange lists the comments on every commit in the range
+// base...head, merged into a single slice sorted by creation time.
+//
+// The commits in the range are found with CompareCommits, which returns at
+// most 250 commits, and the comments of each commit are then listed with
+// ListCommitComments. This costs one request for the comparison plus at
+// least one request per commit in the range against the rate limit, with a
+// bounded number of requests in flight at once. If listing the comments of
+// some commits fails, the comments that were fetched are returned along with
+// a *BatchError keyed by commit SHA.
+func (s *RepositoriesService) ListCommentsForCommitRange(ctx context.Context, owner, repo, base, head string) ([]*RepositoryComment, error) {
+	comp, _, err := s.CompareCommits(ctx, owner, repo, base, head)
+	if err != nil {
+		return nil, err
+	}
+
+	shas := make([]string, len(comp.Commits))
+	for i, c := range comp.Commits {
+		shas[i] = c.GetSHA()
+	}
+
+	perCommit := make([][]*RepositoryComment, len(shas))
+	err = forEachConcurrently(ctx, shas, func(i int) error {
+		opts := &ListOptions{PerPage: 100}
+		_, err := paginate(s.client.maxPages, opts, func() (*Response, error) {
+			comments, resp, err := s.ListCommitComments(ctx, owner, repo, shas[i], opts)
+			perCommit[i] = append(perCommit[i], comments...)
+			return resp, err
+		})
+		return err
+	})
+
+	var all []*RepositoryComment
+	for _, comments := range perCommit {
+		all = append(all, comments...)
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		a, b := all[i].CreatedAt, all[j].CreatedAt
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.Before(*b)
+	})
+	return all, err
+}
+
 // CreateComment creates a comment for the given commit.
 // Note: GitHub allows for comments to be created for non-existing files and positions.
 //
@@ -76,6 +76,66 @@ func TestRepositoriesService_ListCommitComments_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_ListCommentsForCommitRange(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"commits": [{"sha": "s1"}, {"sha": "s2"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/s1/comments?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1, "created_at": "2021-01-01T00:00:00Z"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":4, "created_at": "2021-01-04T00:00:00Z"}]`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/commits/s2/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":3, "created_at": "2021-01-03T00:00:00Z"}, {"id":2, "created_at": "2021-01-02T00:00:00Z"}]`)
+	})
+
+	comments, err := client.Repositories.ListCommentsForCommitRange(context.Background(), "o", "r", "b", "h")
+	if err != nil {
+		t.Fatalf("Repositories.ListCommentsForCommitRange returned error: %v", err)
+	}
+
+	var got []int64
+	for _, c := range comments {
+		got = append(got, c.GetID())
+	}
+	if want := []int64{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListCommentsForCommitRange returned comment IDs %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListCommentsForCommitRange_partialFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"commits": [{"sha": "s1"}, {"sha": "s2"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s1/comments", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s2/comments", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	comments, err := client.Repositories.ListCommentsForCommitRange(context.Background(), "o", "r", "b", "h")
+	if be, ok := err.(*BatchError); !ok || be.Errors["s2"] == nil {
+		t.Errorf("Repositories.ListCommentsForCommitRange returned error %v, want *BatchError for s2", err)
+	}
+	if want := []*RepositoryComment{{ID: Int64(1)}}; !reflect.DeepEqual(comments, want) {
+		t.Errorf("Repositories.ListCommentsForCommitRange returned %+v, want %+v", comments, want)
+	}
+}
+
 func TestRepositoriesService_CreateComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
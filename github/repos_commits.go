@@ -8,7 +8,10 @@ package github
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"time"
 )
 
@@ -145,6 +148,55 @@ func (s *RepositoriesService) ListCommits(ctx context.Context, owner, repo strin
 	return commits, resp, nil
 }
 
+// PathNotFoundError is returned by GetLastCommitForPath when no commit
+// reachable from the ref touched the path, typically because the path does
+// not exist.
+type PathNotFoundError struct {
+	Ref  string // ref that was looked up
+	Path string // path that was looked up
+
+	// ErrorResponse is the underlying 404 response returned by GitHub when
+	// the ref or the repository does not exist. It is nil otherwise.
+	ErrorResponse *ErrorResponse
+}
+
+func (e *PathNotFoundError) Error() string {
+	if e.ErrorResponse != nil {
+		return fmt.Sprintf("no commit found for path %q at ref %q: %v", e.Path, e.Ref, e.ErrorResponse)
+	}
+	return fmt.Sprintf("no commit found for path %q at ref %q", e.Path, e.Ref)
+}
+
+// Unwrap returns the underlying *ErrorResponse, if any.
+func (e *PathNotFoundError) Unwrap() error {
+	if e.ErrorResponse == nil {
+		return nil
+	}
+	return e.ErrorResponse
+}
+
+// GetLastCommitForPath returns the most recent commit reachable from ref
+// that touched path, which can be a file or a directory. An empty ref means
+// the default branch of the repository. The returned commit is as listed by
+// ListCommits, so it does not include the changed files.
+//
+// If no such commit exists, the returned error is a *PathNotFoundError.
+func (s *RepositoriesService) GetLastCommitForPath(ctx context.Context, owner, repo, ref, path string) (*RepositoryCommit, *Response, error) {
+	opts := &CommitsListOptions{SHA: ref, Path: path, ListOptions: ListOptions{PerPage: 1}}
+	commits, resp, err := s.ListCommits(ctx, owner, repo, opts)
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+		return nil, resp, &PathNotFoundError{Ref: ref, Path: path, ErrorResponse: errResp}
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(commits) == 0 {
+		return nil, resp, &PathNotFoundError{Ref: ref, Path: path}
+	}
+
+	return commits[0], resp, nil
+}
+
 // GetCommit fetches the specified commit, including all details about it.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-single-commit
@@ -240,6 +292,140 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	return comp, resp, nil
 }
 
+// CompareCommitsBasehead compares a range of commits given in the basehead
+// form "BASE...HEAD" accepted by the GitHub API. BASE and HEAD can be
+// branches, tags or SHAs of the repository, or of another repository in the
+// same network given as "OWNER:BRANCH" (or "OWNER:REPO:BRANCH"), which allows
+// comparing a fork with its parent. opts paginates the commits of the
+// comparison.
+//
+// GitHub API docs: https://docs.github.com/en/rest/commits/commits#compare-two-commits
+func (s *RepositoriesService) CompareCommitsBasehead(ctx context.Context, owner, repo string, basehead string, opts *ListOptions) (*CommitsComparison, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/compare/%v", owner, repo, refURLEscape(basehead))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comp := new(CommitsComparison)
+	resp, err := s.client.Do(ctx, req, comp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comp, resp, nil
+}
+
+// ListCommitsInTopologicalOrder lists the commits reachable from head but
+// not from base, ordered so that every commit comes after its parents, as
+// needed to build a changelog. The commits are listed with
+// CompareCommitsBasehead, whose chronological order can place a merged
+// commit after the merge commit that brought it in; this is the same order
+// otherwise. The commits merged by a merge commit come right before it,
+// after those of its first parent.
+//
+// All the pages of the comparison are requested. GitHub compares at most
+// 10,000 commits; beyond that, the result is incomplete. If the pages are
+// capped with Client.WithMaxPages, the commits listed are sorted and returned
+// along with ErrMaxPagesExceeded.
+func (s *RepositoriesService) ListCommitsInTopologicalOrder(ctx context.Context, owner, repo, base, head string) ([]*RepositoryCommit, *Response, error) {
+	var commits []*RepositoryCommit
+	opts := &ListOptions{PerPage: 100}
+	resp, err := paginate(s.client.maxPages, opts, func() (*Response, error) {
+		comp, resp, err := s.CompareCommitsBasehead(ctx, owner, repo, base+"..."+head, opts)
+		if comp != nil {
+			commits = append(commits, comp.Commits...)
+		}
+		return resp, err
+	})
+	if err != nil && err != ErrMaxPagesExceeded {
+		return nil, resp, err
+	}
+
+	return sortCommitsTopologically(commits), resp, err
+}
+
+// ErrTooManyChangedFiles is returned by ChangedFilesBetween when a
+// comparison changes more files than GitHub lists.
+var ErrTooManyChangedFiles = errors.New("github: comparison changes more files than GitHub lists")
+
+// maxComparisonFiles is the number of changed files GitHub lists at most for
+// a comparison.
+const maxComparisonFiles = 300
+
+// ChangedFilesBetween lists the paths of the files changed between base and
+// head, as compared with CompareCommitsBasehead, sorted and without
+// duplicates. Renamed files are listed under both their old and new paths,
+// so that path filters match either side of the rename, as CI systems
+// deciding which jobs to run on a monorepo need.
+//
+// GitHub lists the changed files of a comparison on its first page only, so
+// a single request is made. It lists at most 300 files; when that many are
+// listed, the list may be incomplete, and the paths are returned along with
+// ErrTooManyChangedFiles so that callers can fall back to assuming that
+// everything changed.
+func (s *RepositoriesService) ChangedFilesBetween(ctx context.Context, owner, repo, base, head string) ([]string, *Response, error) {
+	// The files are listed whatever the page size of the commits, so ask
+	// for as few commits as possible.
+	comp, resp, err := s.CompareCommitsBasehead(ctx, owner, repo, base+"..."+head, &ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	seen := make(map[string]bool)
+	for _, f := range comp.Files {
+		seen[f.GetFilename()] = true
+		if f.PreviousFilename != nil {
+			seen[f.GetPreviousFilename()] = true
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	if len(comp.Files) >= maxComparisonFiles {
+		return paths, resp, ErrTooManyChangedFiles
+	}
+	return paths, resp, nil
+}
+
+// sortCommitsTopologically returns commits ordered so that every commit
+// comes after those of its parents that are among commits, keeping the
+// original order where possible.
+func sortCommitsTopologically(commits []*RepositoryCommit) []*RepositoryCommit {
+	bySHA := make(map[string]*RepositoryCommit, len(commits))
+	for _, c := range commits {
+		bySHA[c.GetSHA()] = c
+	}
+
+	sorted := make([]*RepositoryCommit, 0, len(commits))
+	visited := make(map[string]bool, len(commits))
+	var visit func(c *RepositoryCommit)
+	visit = func(c *RepositoryCommit) {
+		if visited[c.GetSHA()] {
+			return
+		}
+		visited[c.GetSHA()] = true
+		for _, p := range c.Parents {
+			if parent, ok := bySHA[p.GetSHA()]; ok {
+				visit(parent)
+			}
+		}
+		sorted = append(sorted, c)
+	}
+	for _, c := range commits {
+		visit(c)
+	}
+	return sorted
+}
+
 // CompareCommitsRaw compares a range of commits with each other in raw (diff or patch) format.
 //
 // Both "base" and "head" must be branch names in "repo".
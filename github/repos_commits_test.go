@@ -51,6 +51,66 @@ func TestRepositoriesService_ListCommits(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetLastCommitForPath(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sha": "main", "path": "docs/README.md", "per_page": "1"})
+		fmt.Fprint(w, `[{"sha":"s","commit":{"message":"Update docs"}}]`)
+	})
+
+	commit, _, err := client.Repositories.GetLastCommitForPath(context.Background(), "o", "r", "main", "docs/README.md")
+	if err != nil {
+		t.Errorf("Repositories.GetLastCommitForPath returned error: %v", err)
+	}
+
+	want := &RepositoryCommit{SHA: String("s"), Commit: &Commit{Message: String("Update docs")}}
+	if !reflect.DeepEqual(commit, want) {
+		t.Errorf("Repositories.GetLastCommitForPath returned %+v, want %+v", commit, want)
+	}
+}
+
+func TestRepositoriesService_GetLastCommitForPath_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	_, _, err := client.Repositories.GetLastCommitForPath(context.Background(), "o", "r", "", "missing")
+	perr, ok := err.(*PathNotFoundError)
+	if !ok {
+		t.Fatalf("Repositories.GetLastCommitForPath returned error %v, want *PathNotFoundError", err)
+	}
+	if perr.Path != "missing" || perr.ErrorResponse != nil {
+		t.Errorf("Repositories.GetLastCommitForPath returned %+v", perr)
+	}
+	if perr.Unwrap() != nil {
+		t.Errorf("PathNotFoundError.Unwrap() = %v, want nil", perr.Unwrap())
+	}
+}
+
+func TestRepositoriesService_GetLastCommitForPath_unknownRef(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"No commit found for SHA: nope"}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.Repositories.GetLastCommitForPath(context.Background(), "o", "r", "nope", "README.md")
+	perr, ok := err.(*PathNotFoundError)
+	if !ok {
+		t.Fatalf("Repositories.GetLastCommitForPath returned error %v, want *PathNotFoundError", err)
+	}
+	if perr.ErrorResponse == nil || perr.Ref != "nope" {
+		t.Errorf("Repositories.GetLastCommitForPath returned %+v", perr)
+	}
+}
+
 func TestRepositoriesService_GetCommit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -400,6 +460,133 @@ func TestRepositoriesService_CompareCommits(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CompareCommitsBasehead_crossFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/o:main...f:feature/x", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{"status":"diverged","ahead_by":3,"behind_by":1,"total_commits":3,"commits":[{"sha":"s"}]}`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	got, _, err := client.Repositories.CompareCommitsBasehead(context.Background(), "o", "r", "o:main...f:feature/x", opts)
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsBasehead returned error: %v", err)
+	}
+
+	want := &CommitsComparison{
+		Status:       String("diverged"),
+		AheadBy:      Int(3),
+		BehindBy:     Int(1),
+		TotalCommits: Int(3),
+		Commits:      []*RepositoryCommit{{SHA: String("s")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CompareCommitsBasehead returned \n%+v, want \n%+v", got, want)
+	}
+}
+
+func TestRepositoriesService_CompareCommitsBasehead_invalidOwner(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Repositories.CompareCommitsBasehead(context.Background(), "%", "r", "b...h", nil)
+	testURLParseError(t, err)
+}
+
+func TestRepositoriesService_ListCommitsInTopologicalOrder(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The history is b - a1 - a2 - m on main, with m merging f2 from the
+	// feature branch b - f1 - f2. f2 is listed after m.
+	mux.HandleFunc("/repos/o/r/compare/b...m", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...m?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"commits":[
+				{"sha":"a1","parents":[{"sha":"b"}]},
+				{"sha":"f1","parents":[{"sha":"b"}]},
+				{"sha":"a2","parents":[{"sha":"a1"}]}
+			]}`)
+		case "2":
+			fmt.Fprint(w, `{"commits":[
+				{"sha":"m","parents":[{"sha":"a2"},{"sha":"f2"}]},
+				{"sha":"f2","parents":[{"sha":"f1"}]}
+			]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	commits, _, err := client.Repositories.ListCommitsInTopologicalOrder(context.Background(), "o", "r", "b", "m")
+	if err != nil {
+		t.Fatalf("Repositories.ListCommitsInTopologicalOrder returned error: %v", err)
+	}
+
+	var got []string
+	for _, c := range commits {
+		got = append(got, c.GetSHA())
+	}
+	want := []string{"a1", "f1", "a2", "f2", "m"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListCommitsInTopologicalOrder returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_ChangedFilesBetween(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...h?per_page=1&page=2>; rel="next"`)
+		fmt.Fprint(w, `{
+			"commits":[{"sha":"c1"}],
+			"files":[
+				{"filename":"services/api/main.go","status":"modified"},
+				{"filename":"docs/README.md","status":"added"},
+				{"filename":"libs/util/strings.go","previous_filename":"libs/strings.go","status":"renamed"}
+			]
+		}`)
+	})
+
+	paths, _, err := client.Repositories.ChangedFilesBetween(context.Background(), "o", "r", "b", "h")
+	if err != nil {
+		t.Fatalf("Repositories.ChangedFilesBetween returned error: %v", err)
+	}
+
+	want := []string{"docs/README.md", "libs/strings.go", "libs/util/strings.go", "services/api/main.go"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Repositories.ChangedFilesBetween returned %v, want %v", paths, want)
+	}
+}
+
+func TestRepositoriesService_ChangedFilesBetween_tooManyFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	files := make([]string, maxComparisonFiles)
+	for i := range files {
+		files[i] = fmt.Sprintf(`{"filename":"f%03d"}`, i)
+	}
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"files":[%v]}`, strings.Join(files, ","))
+	})
+
+	paths, _, err := client.Repositories.ChangedFilesBetween(context.Background(), "o", "r", "b", "h")
+	if err != ErrTooManyChangedFiles {
+		t.Errorf("Repositories.ChangedFilesBetween returned error %v, want ErrTooManyChangedFiles", err)
+	}
+	if len(paths) != maxComparisonFiles {
+		t.Errorf("Repositories.ChangedFilesBetween returned %v paths, want %v", len(paths), maxComparisonFiles)
+	}
+}
+
 func TestRepositoriesService_CompareCommitsRaw_diff(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
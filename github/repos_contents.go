@@ -179,6 +179,116 @@ func (s *RepositoriesService) GetContents(ctx context.Context, owner, repo, path
 	return nil, nil, resp, fmt.Errorf("unmarshalling failed for both file and directory content: %s and %s", fileUnmarshalError, directoryUnmarshalError)
 }
 
+// FileComparison holds the contents of a file at two refs, as returned by
+// CompareFileAcrossRefs. Base or Head is nil if the file does not exist at
+// that ref.
+type FileComparison struct {
+	Base    *RepositoryContent
+	Head    *RepositoryContent
+	Changed bool // Whether the file differs between the two refs.
+}
+
+// CompareFileAcrossRefs fetches the file at path from both baseRef and
+// headRef concurrently and reports whether it changed between them. A file
+// that exists at only one of the refs is reported as changed, with the
+// content for the other ref left nil; if the file exists at neither ref, the
+// error from the base ref is returned.
+//
+// An error is returned if path is a directory. If fetching either version
+// fails for any other reason, a *BatchError describes the failures, keyed
+// "base" or "head" so that they are told apart even when baseRef and headRef
+// are the same.
+func (s *RepositoriesService) CompareFileAcrossRefs(ctx context.Context, owner, repo, path, baseRef, headRef string) (*FileComparison, error) {
+	refs := []string{baseRef, headRef}
+	contents := make([]*RepositoryContent, len(refs))
+	notFound := make([]error, len(refs))
+	err := forEachConcurrently(ctx, []string{"base", "head"}, func(i int) error {
+		file, dir, _, err := s.GetContents(ctx, owner, repo, path, &RepositoryContentGetOptions{Ref: refs[i]})
+		if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+			notFound[i] = err
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if dir != nil {
+			return fmt.Errorf("%v is a directory at %v", path, refs[i])
+		}
+		contents[i] = file
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if notFound[0] != nil && notFound[1] != nil {
+		return nil, notFound[0]
+	}
+
+	base, head := contents[0], contents[1]
+	changed := base == nil || head == nil || base.GetSHA() != head.GetSHA()
+	return &FileComparison{Base: base, Head: head, Changed: changed}, nil
+}
+
+// WalkTree calls fn for every entry of the tree of the repository at ref,
+// which may be a commit SHA, branch or tag name: blobs (files), trees
+// (directories) and commits (submodules). path is the path of the entry from
+// the root of the repository, which is also set as entry.Path. Directories
+// are visited before their contents.
+//
+// The whole tree is fetched with a single recursive Git.GetTree request
+// when possible. If GitHub truncates it because the repository is too large,
+// the tree is walked one directory at a time instead, which makes one request
+// per directory.
+//
+// Walking stops at the first error returned by fn, which WalkTree then
+// returns, or when ctx is done.
+func (s *RepositoriesService) WalkTree(ctx context.Context, owner, repo, ref string, fn func(path string, entry *TreeEntry) error) error {
+	tree, _, err := s.client.Git.GetTree(ctx, owner, repo, ref, true)
+	if err != nil {
+		return err
+	}
+	if tree.GetTruncated() {
+		return s.walkTreeByDirectory(ctx, owner, repo, ref, "", fn)
+	}
+
+	for _, entry := range tree.Entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(entry.GetPath(), entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkTreeByDirectory walks the tree sha, whose path is dir, fetching each
+// of its subtrees non-recursively.
+func (s *RepositoriesService) walkTreeByDirectory(ctx context.Context, owner, repo, sha, dir string, fn func(path string, entry *TreeEntry) error) error {
+	tree, _, err := s.client.Git.GetTree(ctx, owner, repo, sha, false)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range tree.Entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entry := *e
+		p := path.Join(dir, e.GetPath())
+		entry.Path = &p
+		if err := fn(p, &entry); err != nil {
+			return err
+		}
+		if entry.GetType() == "tree" {
+			if err := s.walkTreeByDirectory(ctx, owner, repo, entry.GetSHA(), p, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // CreateFile creates a new file in a repository at the given path and returns
 // the commit and file metadata.
 //
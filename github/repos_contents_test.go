@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -304,6 +305,195 @@ func TestRepositoriesService_GetContents_Directory(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CompareFileAcrossRefs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/contents/config.yml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch ref := r.FormValue("ref"); ref {
+		case "staging":
+			fmt.Fprint(w, `{"type":"file","path":"config.yml","sha":"s1"}`)
+		case "production":
+			fmt.Fprint(w, `{"type":"file","path":"config.yml","sha":"s2"}`)
+		case "mirror":
+			fmt.Fprint(w, `{"type":"file","path":"config.yml","sha":"s1"}`)
+		default:
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		base, head  string
+		wantBase    *RepositoryContent
+		wantHead    *RepositoryContent
+		wantChanged bool
+	}{
+		{
+			base:        "staging",
+			head:        "production",
+			wantBase:    &RepositoryContent{Type: String("file"), Path: String("config.yml"), SHA: String("s1")},
+			wantHead:    &RepositoryContent{Type: String("file"), Path: String("config.yml"), SHA: String("s2")},
+			wantChanged: true,
+		},
+		{
+			base:        "staging",
+			head:        "mirror",
+			wantBase:    &RepositoryContent{Type: String("file"), Path: String("config.yml"), SHA: String("s1")},
+			wantHead:    &RepositoryContent{Type: String("file"), Path: String("config.yml"), SHA: String("s1")},
+			wantChanged: false,
+		},
+		{
+			base:        "old",
+			head:        "production",
+			wantHead:    &RepositoryContent{Type: String("file"), Path: String("config.yml"), SHA: String("s2")},
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := client.Repositories.CompareFileAcrossRefs(context.Background(), "o", "r", "config.yml", tt.base, tt.head)
+		if err != nil {
+			t.Errorf("CompareFileAcrossRefs(%v, %v) returned error: %v", tt.base, tt.head, err)
+			continue
+		}
+		want := &FileComparison{Base: tt.wantBase, Head: tt.wantHead, Changed: tt.wantChanged}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CompareFileAcrossRefs(%v, %v) = %+v, want %+v", tt.base, tt.head, got, want)
+		}
+	}
+
+	_, err := client.Repositories.CompareFileAcrossRefs(context.Background(), "o", "r", "config.yml", "old", "older")
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("CompareFileAcrossRefs returned error %v, want a 404 *ErrorResponse", err)
+	}
+}
+
+func TestRepositoriesService_CompareFileAcrossRefs_directory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"type":"file","name":"a","path":"p/a"}]`)
+	})
+
+	_, err := client.Repositories.CompareFileAcrossRefs(context.Background(), "o", "r", "p", "a", "a")
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("CompareFileAcrossRefs returned error %v, want *BatchError", err)
+	}
+	if len(batchErr.Errors) != 2 || batchErr.Errors["base"] == nil || batchErr.Errors["head"] == nil {
+		t.Errorf("BatchError.Errors is %v, want base and head", batchErr.Errors)
+	}
+}
+
+func TestRepositoriesService_WalkTree(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/git/trees/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		fmt.Fprint(w, `{"sha":"s","tree":[
+			{"path":"README.md","type":"blob","sha":"b1"},
+			{"path":"src","type":"tree","sha":"t1"},
+			{"path":"src/main.go","type":"blob","sha":"b2"}
+		],"truncated":false}`)
+	})
+
+	var paths []string
+	err := client.Repositories.WalkTree(context.Background(), "o", "r", "main", func(path string, entry *TreeEntry) error {
+		if path != entry.GetPath() {
+			t.Errorf("WalkTree called fn with path %q for entry %v", path, entry)
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.WalkTree returned error: %v", err)
+	}
+
+	want := []string{"README.md", "src", "src/main.go"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Repositories.WalkTree visited %v, want %v", paths, want)
+	}
+}
+
+func TestRepositoriesService_WalkTree_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/git/trees/main", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("recursive") != "" {
+			fmt.Fprint(w, `{"sha":"s","tree":[{"path":"README.md","type":"blob","sha":"b1"}],"truncated":true}`)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"s","tree":[
+			{"path":"README.md","type":"blob","sha":"b1"},
+			{"path":"src","type":"tree","sha":"t1"},
+			{"path":"vendor","type":"commit","sha":"c1"}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t1", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{})
+		fmt.Fprint(w, `{"sha":"t1","tree":[
+			{"path":"main.go","type":"blob","sha":"b2"},
+			{"path":"pkg","type":"tree","sha":"t2"}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"t2","tree":[{"path":"util.go","type":"blob","sha":"b3"}]}`)
+	})
+
+	var paths []string
+	err := client.Repositories.WalkTree(context.Background(), "o", "r", "main", func(path string, entry *TreeEntry) error {
+		if path != entry.GetPath() {
+			t.Errorf("WalkTree called fn with path %q for entry %v", path, entry)
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.WalkTree returned error: %v", err)
+	}
+
+	want := []string{"README.md", "src", "src/main.go", "src/pkg", "src/pkg/util.go", "vendor"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Repositories.WalkTree visited %v, want %v", paths, want)
+	}
+}
+
+func TestRepositoriesService_WalkTree_stopOnError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/git/trees/main", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"s","tree":[{"path":"a","type":"blob"},{"path":"b","type":"blob"}]}`)
+	})
+
+	wantErr := errors.New("stop")
+	calls := 0
+	err := client.Repositories.WalkTree(context.Background(), "o", "r", "main", func(path string, entry *TreeEntry) error {
+		calls++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Errorf("Repositories.WalkTree returned error %v, want %v", err, wantErr)
+	}
+	if calls != 1 {
+		t.Errorf("Repositories.WalkTree called fn %v times after it failed, want 1", calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	err = client.Repositories.WalkTree(ctx, "o", "r", "main", func(path string, entry *TreeEntry) error {
+		calls++
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Repositories.WalkTree returned error %v, want %v", err, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("Repositories.WalkTree called fn %v times after cancellation, want 1", calls)
+	}
+}
+
 func TestRepositoriesService_CreateFile(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
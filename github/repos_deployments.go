@@ -201,6 +201,137 @@ func (s *RepositoriesService) ListDeploymentStatuses(ctx context.Context, owner,
 	return statuses, resp, nil
 }
 
+// ListDeploymentStatusesAll lists all the statuses of a given deployment of a
+// repository, following pagination until every page has been fetched.
+// opts.Page is used as the first page to fetch. Statuses are returned newest
+// first.
+//
+// If a request fails, the statuses fetched so far are returned along with the
+// error.
+func (s *RepositoriesService) ListDeploymentStatusesAll(ctx context.Context, owner, repo string, deployment int64, opts *ListOptions) ([]*DeploymentStatus, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*DeploymentStatus
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		statuses, resp, err := s.ListDeploymentStatuses(ctx, owner, repo, deployment, o)
+		all = append(all, statuses...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
+// GetLatestDeploymentStatus returns the most recent status of a given
+// deployment of a repository, which reflects the current state of the
+// deployment. If the deployment has no statuses yet, a nil status is
+// returned without error.
+func (s *RepositoriesService) GetLatestDeploymentStatus(ctx context.Context, owner, repo string, deploymentID int64) (*DeploymentStatus, *Response, error) {
+	statuses, resp, err := s.ListDeploymentStatuses(ctx, owner, repo, deploymentID, &ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(statuses) == 0 {
+		return nil, resp, nil
+	}
+
+	return statuses[0], resp, nil
+}
+
+// DeploymentWithStatus is a deployment together with its most recent status,
+// as returned by ListEnvironmentDeploymentHistory and ListDeploymentsForSHA.
+type DeploymentWithStatus struct {
+	Deployment *Deployment
+	// LatestStatus is nil if the deployment has no statuses yet.
+	LatestStatus *DeploymentStatus
+}
+
+// ListEnvironmentDeploymentHistory lists one page of the deployments of a
+// repository to the given environment, newest first, each with its most
+// recent status attached. The returned Response is that of the deployment
+// listing, and can be used to page through the history with opts.
+//
+// Besides listing the deployments, one GetLatestDeploymentStatus request is
+// made per deployment, with a bounded number of requests in flight at once,
+// so each page costs 1 + len(page) requests against the rate limit; keep
+// opts.PerPage small for frequently refreshed views. If fetching some of
+// the statuses fails, every deployment is still returned, with a nil
+// LatestStatus for the failed ones, along with a *BatchError keyed by
+// deployment ID.
+func (s *RepositoriesService) ListEnvironmentDeploymentHistory(ctx context.Context, owner, repo, environment string, opts *ListOptions) ([]*DeploymentWithStatus, *Response, error) {
+	listOpts := &DeploymentsListOptions{Environment: environment}
+	if opts != nil {
+		listOpts.ListOptions = *opts
+	}
+	deployments, resp, err := s.ListDeployments(ctx, owner, repo, listOpts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	history, err := s.withLatestStatuses(ctx, owner, repo, deployments)
+	if err != nil {
+		return history, resp, err
+	}
+
+	return history, resp, nil
+}
+
+// ListDeploymentsForSHA lists all the deployments of a repository created
+// for the commit sha, across every environment, newest first, each with its
+// most recent status attached. It answers where a commit is deployed: the
+// environment of each deployment is in Deployment.Environment, and whether
+// it is live in LatestStatus.
+//
+// The deployments are listed with as many requests as it takes to page
+// through them, then one GetLatestDeploymentStatus request is made per
+// deployment, with a bounded number of requests in flight at once. A commit
+// deployed often, such as a long-lived release, can therefore cost many
+// requests against the rate limit. If listing the deployments fails, the
+// error is returned with no deployments, unless it is ErrMaxPagesExceeded:
+// the deployments listed are then returned with their statuses, along with
+// that error. If fetching some of the statuses fails, every deployment is
+// still returned, with a nil LatestStatus for the failed ones, along with a
+// *BatchError keyed by deployment ID.
+func (s *RepositoriesService) ListDeploymentsForSHA(ctx context.Context, owner, repo, sha string) ([]*DeploymentWithStatus, *Response, error) {
+	opts := &DeploymentsListOptions{SHA: sha, ListOptions: ListOptions{PerPage: 100}}
+	var deployments []*Deployment
+	resp, err := paginate(s.client.maxPages, &opts.ListOptions, func() (*Response, error) {
+		page, resp, err := s.ListDeployments(ctx, owner, repo, opts)
+		deployments = append(deployments, page...)
+		return resp, err
+	})
+	if err != nil && err != ErrMaxPagesExceeded {
+		return nil, resp, err
+	}
+
+	result, statusErr := s.withLatestStatuses(ctx, owner, repo, deployments)
+	if statusErr != nil {
+		return result, resp, statusErr
+	}
+
+	return result, resp, err
+}
+
+// withLatestStatuses pairs each of deployments with its most recent status,
+// fetching the statuses concurrently. Deployments whose status could not be
+// fetched are returned with a nil LatestStatus, along with a *BatchError
+// keyed by deployment ID.
+func (s *RepositoriesService) withLatestStatuses(ctx context.Context, owner, repo string, deployments []*Deployment) ([]*DeploymentWithStatus, error) {
+	result := make([]*DeploymentWithStatus, len(deployments))
+	keys := make([]string, len(deployments))
+	for i, d := range deployments {
+		result[i] = &DeploymentWithStatus{Deployment: d}
+		keys[i] = fmt.Sprint(d.GetID())
+	}
+	err := forEachConcurrently(ctx, keys, func(i int) error {
+		status, _, err := s.GetLatestDeploymentStatus(ctx, owner, repo, deployments[i].GetID())
+		result[i].LatestStatus = status
+		return err
+	})
+	return result, err
+}
+
 // GetDeploymentStatus returns a single deployment status of a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-deployment-status
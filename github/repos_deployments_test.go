@@ -139,6 +139,190 @@ func TestRepositoriesService_ListDeploymentStatuses(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListDeploymentStatusesAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/deployments/1/statuses?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":3,"state":"success"},{"id":2,"state":"in_progress"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":1,"state":"queued"}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	statuses, _, err := client.Repositories.ListDeploymentStatusesAll(context.Background(), "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("Repositories.ListDeploymentStatusesAll returned error: %v", err)
+	}
+
+	want := []*DeploymentStatus{
+		{ID: Int64(3), State: String("success")},
+		{ID: Int64(2), State: String("in_progress")},
+		{ID: Int64(1), State: String("queued")},
+	}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("Repositories.ListDeploymentStatusesAll returned %+v, want %+v", statuses, want)
+	}
+}
+
+func TestRepositoriesService_GetLatestDeploymentStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"id":3,"state":"success"}]`)
+	})
+
+	status, _, err := client.Repositories.GetLatestDeploymentStatus(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetLatestDeploymentStatus returned error: %v", err)
+	}
+
+	want := &DeploymentStatus{ID: Int64(3), State: String("success")}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Repositories.GetLatestDeploymentStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestRepositoriesService_GetLatestDeploymentStatus_none(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	status, _, err := client.Repositories.GetLatestDeploymentStatus(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetLatestDeploymentStatus returned error: %v", err)
+	}
+	if status != nil {
+		t.Errorf("Repositories.GetLatestDeploymentStatus returned %+v, want nil", status)
+	}
+}
+
+func TestRepositoriesService_ListEnvironmentDeploymentHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"environment": "production", "per_page": "2"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/deployments?environment=production&per_page=2&page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"id":2,"environment":"production"},{"id":1,"environment":"production"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"id":20,"state":"in_progress"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"id":10,"state":"success"}]`)
+	})
+
+	history, resp, err := client.Repositories.ListEnvironmentDeploymentHistory(context.Background(), "o", "r", "production", &ListOptions{PerPage: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListEnvironmentDeploymentHistory returned error: %v", err)
+	}
+
+	want := []*DeploymentWithStatus{
+		{
+			Deployment:   &Deployment{ID: Int64(2), Environment: String("production")},
+			LatestStatus: &DeploymentStatus{ID: Int64(20), State: String("in_progress")},
+		},
+		{
+			Deployment:   &Deployment{ID: Int64(1), Environment: String("production")},
+			LatestStatus: &DeploymentStatus{ID: Int64(10), State: String("success")},
+		},
+	}
+	if !reflect.DeepEqual(history, want) {
+		t.Errorf("Repositories.ListEnvironmentDeploymentHistory returned %+v, want %+v", history, want)
+	}
+	if resp.NextPage != 2 {
+		t.Errorf("Repositories.ListEnvironmentDeploymentHistory returned NextPage %v, want 2", resp.NextPage)
+	}
+}
+
+func TestRepositoriesService_ListEnvironmentDeploymentHistory_statusError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":2},{"id":1}]`)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":10}]`)
+	})
+
+	history, _, err := client.Repositories.ListEnvironmentDeploymentHistory(context.Background(), "o", "r", "production", nil)
+	berr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.ListEnvironmentDeploymentHistory returned error %v, want *BatchError", err)
+	}
+	if _, ok := berr.Errors["2"]; !ok || len(berr.Errors) != 1 {
+		t.Errorf("BatchError.Errors = %v, want an error for deployment 2 only", berr.Errors)
+	}
+	if len(history) != 2 || history[0].LatestStatus != nil || history[1].LatestStatus.GetID() != 10 {
+		t.Errorf("Repositories.ListEnvironmentDeploymentHistory returned %+v", history)
+	}
+}
+
+func TestRepositoriesService_ListDeploymentsForSHA(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"sha": "abc", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/deployments?sha=abc&per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":2,"sha":"abc","environment":"production"}]`)
+		case "2":
+			testFormValues(t, r, values{"sha": "abc", "per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[{"id":1,"sha":"abc","environment":"staging"}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+	mux.HandleFunc("/repos/o/r/deployments/2/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `[{"id":20,"state":"success"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	deployments, _, err := client.Repositories.ListDeploymentsForSHA(context.Background(), "o", "r", "abc")
+	if err != nil {
+		t.Errorf("Repositories.ListDeploymentsForSHA returned error: %v", err)
+	}
+
+	want := []*DeploymentWithStatus{
+		{
+			Deployment:   &Deployment{ID: Int64(2), SHA: String("abc"), Environment: String("production")},
+			LatestStatus: &DeploymentStatus{ID: Int64(20), State: String("success")},
+		},
+		{
+			Deployment: &Deployment{ID: Int64(1), SHA: String("abc"), Environment: String("staging")},
+		},
+	}
+	if !reflect.DeepEqual(deployments, want) {
+		t.Errorf("Repositories.ListDeploymentsForSHA returned %+v, want %+v", deployments, want)
+	}
+}
+
 func TestRepositoriesService_GetDeploymentStatus(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
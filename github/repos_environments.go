@@ -0,0 +1,193 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Environment represents a single deployment environment in a repository.
+type Environment struct {
+	ID                     *int64            `json:"id,omitempty"`
+	NodeID                 *string           `json:"node_id,omitempty"`
+	Name                   *string           `json:"name,omitempty"`
+	URL                    *string           `json:"url,omitempty"`
+	HTMLURL                *string           `json:"html_url,omitempty"`
+	CreatedAt              *Timestamp        `json:"created_at,omitempty"`
+	UpdatedAt              *Timestamp        `json:"updated_at,omitempty"`
+	ProtectionRules        []*ProtectionRule `json:"protection_rules,omitempty"`
+	DeploymentBranchPolicy *BranchPolicy     `json:"deployment_branch_policy,omitempty"`
+}
+
+func (e Environment) String() string {
+	return Stringify(e)
+}
+
+// ProtectionRule represents a single protection rule of an environment.
+type ProtectionRule struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Type is the type of the rule. Possible values are: required_reviewers,
+	// wait_timer, branch_policy.
+	Type *string `json:"type,omitempty"`
+	// WaitTimer is the number of minutes to wait before deploying, for
+	// wait_timer rules.
+	WaitTimer *int `json:"wait_timer,omitempty"`
+	// Reviewers are the users and teams that can approve deployments, for
+	// required_reviewers rules.
+	Reviewers []*RequiredReviewer `json:"reviewers,omitempty"`
+}
+
+// RequiredReviewer represents a user or team that can approve the
+// deployments to an environment.
+type RequiredReviewer struct {
+	// Type is the type of the reviewer. Possible values are: User, Team.
+	Type *string `json:"type,omitempty"`
+	// Reviewer is a *User or a *Team, depending on Type. For any other
+	// Type, it holds the undecoded json.RawMessage.
+	Reviewer interface{} `json:"reviewer,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding
+// Reviewer as a *User or *Team depending on Type. Reviewers of an unknown
+// Type are kept as a json.RawMessage, so that new reviewer types do not
+// break the decoding of the environment.
+func (r *RequiredReviewer) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     *string         `json:"type,omitempty"`
+		Reviewer json.RawMessage `json:"reviewer,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Type = raw.Type
+	r.Reviewer = nil
+	if raw.Type == nil || len(raw.Reviewer) == 0 {
+		return nil
+	}
+	switch *raw.Type {
+	case "User":
+		r.Reviewer = new(User)
+	case "Team":
+		r.Reviewer = new(Team)
+	default:
+		r.Reviewer = raw.Reviewer
+		return nil
+	}
+	return json.Unmarshal(raw.Reviewer, r.Reviewer)
+}
+
+// BranchPolicy represents the branches that can deploy to an environment.
+type BranchPolicy struct {
+	// ProtectedBranches is whether only branches with branch protection
+	// rules can deploy to the environment.
+	ProtectedBranches *bool `json:"protected_branches,omitempty"`
+	// CustomBranchPolicies is whether only branches matching the custom
+	// name patterns of the environment can deploy to it.
+	CustomBranchPolicies *bool `json:"custom_branch_policies,omitempty"`
+}
+
+// EnvResponse represents the environments of a repository, as returned by
+// ListEnvironments.
+type EnvResponse struct {
+	TotalCount   *int           `json:"total_count,omitempty"`
+	Environments []*Environment `json:"environments,omitempty"`
+}
+
+// ListEnvironments lists the environments of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/environments#list-environments
+func (s *RepositoriesService) ListEnvironments(ctx context.Context, owner, repo string, opts *ListOptions) (*EnvResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	envs := new(EnvResponse)
+	resp, err := s.client.Do(ctx, req, envs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return envs, resp, nil
+}
+
+// GetEnvironment gets a single environment of a repository, with its
+// protection rules.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/environments#get-an-environment
+func (s *RepositoriesService) GetEnvironment(ctx context.Context, owner, repo, name string) (*Environment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v", owner, repo, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	env := new(Environment)
+	resp, err := s.client.Do(ctx, req, env)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return env, resp, nil
+}
+
+// ListEnvironmentsWithProtectionDetail lists all the environments of a
+// repository, following pagination, and fetches each of them with
+// GetEnvironment so that their protection rules, reviewers and deployment
+// branch policy are fully populated.
+//
+// Besides listing the environments, one GetEnvironment request is made per
+// environment, with a bounded number of requests in flight at once, so this
+// costs 1 + len(environments) requests against the rate limit (plus one per
+// additional page of environments). If fetching some of the environments
+// fails, every environment is still returned, as listed for the failed ones,
+// along with a *BatchError keyed by environment name. If the pages of
+// environments are capped with Client.WithMaxPages, the environments listed
+// are fetched and returned along with ErrMaxPagesExceeded.
+func (s *RepositoriesService) ListEnvironmentsWithProtectionDetail(ctx context.Context, owner, repo string) ([]*Environment, *Response, error) {
+	o := new(ListOptions)
+	var envs []*Environment
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		page, resp, err := s.ListEnvironments(ctx, owner, repo, o)
+		if err != nil {
+			return resp, err
+		}
+		envs = append(envs, page.Environments...)
+		return resp, nil
+	})
+	if err != nil && err != ErrMaxPagesExceeded {
+		return nil, resp, err
+	}
+	pageErr := err
+
+	names := make([]string, len(envs))
+	for i, env := range envs {
+		names[i] = env.GetName()
+	}
+	err = forEachConcurrently(ctx, names, func(i int) error {
+		env, _, err := s.GetEnvironment(ctx, owner, repo, names[i])
+		if err != nil {
+			return err
+		}
+		envs[i] = env
+		return nil
+	})
+	if err != nil {
+		return envs, resp, err
+	}
+
+	return envs, resp, pageErr
+}
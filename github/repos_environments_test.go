@@ -0,0 +1,146 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRequiredReviewer_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want *RequiredReviewer
+	}{
+		{`{"type":"User","reviewer":{"id":1,"login":"u"}}`, &RequiredReviewer{Type: String("User"), Reviewer: &User{ID: Int64(1), Login: String("u")}}},
+		{`{"type":"Team","reviewer":{"id":2,"slug":"t"}}`, &RequiredReviewer{Type: String("Team"), Reviewer: &Team{ID: Int64(2), Slug: String("t")}}},
+		{`{"type":"User"}`, &RequiredReviewer{Type: String("User")}},
+		{`{"type":"Bot","reviewer":{"id":3}}`, &RequiredReviewer{Type: String("Bot"), Reviewer: json.RawMessage(`{"id":3}`)}},
+	}
+	for _, tt := range tests {
+		got := new(RequiredReviewer)
+		if err := json.Unmarshal([]byte(tt.data), got); err != nil {
+			t.Errorf("Unmarshal(%v) returned error: %v", tt.data, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Unmarshal(%v) = %+v, want %+v", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestRepositoriesService_ListEnvironments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"environments":[{"id":1,"name":"staging"},{"id":2,"name":"production"}]}`)
+	})
+
+	envs, _, err := client.Repositories.ListEnvironments(context.Background(), "o", "r", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListEnvironments returned error: %v", err)
+	}
+
+	want := &EnvResponse{
+		TotalCount: Int(2),
+		Environments: []*Environment{
+			{ID: Int64(1), Name: String("staging")},
+			{ID: Int64(2), Name: String("production")},
+		},
+	}
+	if !reflect.DeepEqual(envs, want) {
+		t.Errorf("Repositories.ListEnvironments returned %+v, want %+v", envs, want)
+	}
+}
+
+func TestRepositoriesService_GetEnvironment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 2,
+			"name": "production",
+			"protection_rules": [
+				{"id": 3, "type": "wait_timer", "wait_timer": 30},
+				{"id": 4, "type": "required_reviewers", "reviewers": [{"type": "Team", "reviewer": {"id": 5, "slug": "ops"}}]}
+			],
+			"deployment_branch_policy": {"protected_branches": true, "custom_branch_policies": false}
+		}`)
+	})
+
+	env, _, err := client.Repositories.GetEnvironment(context.Background(), "o", "r", "production")
+	if err != nil {
+		t.Errorf("Repositories.GetEnvironment returned error: %v", err)
+	}
+
+	want := &Environment{
+		ID:   Int64(2),
+		Name: String("production"),
+		ProtectionRules: []*ProtectionRule{
+			{ID: Int64(3), Type: String("wait_timer"), WaitTimer: Int(30)},
+			{ID: Int64(4), Type: String("required_reviewers"), Reviewers: []*RequiredReviewer{
+				{Type: String("Team"), Reviewer: &Team{ID: Int64(5), Slug: String("ops")}},
+			}},
+		},
+		DeploymentBranchPolicy: &BranchPolicy{ProtectedBranches: Bool(true), CustomBranchPolicies: Bool(false)},
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Repositories.GetEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
+func TestRepositoriesService_ListEnvironmentsWithProtectionDetail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments", func(w http.ResponseWriter, r *http.Request) {
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/environments?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":3,"environments":[{"id":1,"name":"staging"},{"id":2,"name":"production"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":3,"environments":[{"id":3,"name":"qa"}]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/environments/staging", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"name":"staging","deployment_branch_policy":{"protected_branches":false,"custom_branch_policies":true}}`)
+	})
+	mux.HandleFunc("/repos/o/r/environments/production", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":2,"name":"production","protection_rules":[{"id":4,"type":"wait_timer","wait_timer":30}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/environments/qa", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	envs, _, err := client.Repositories.ListEnvironmentsWithProtectionDetail(context.Background(), "o", "r")
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.ListEnvironmentsWithProtectionDetail returned error %v, want *BatchError", err)
+	}
+	if len(batchErr.Errors) != 1 || batchErr.Errors["qa"] == nil {
+		t.Errorf("Repositories.ListEnvironmentsWithProtectionDetail errors = %v, want a failure for qa", batchErr.Errors)
+	}
+
+	want := []*Environment{
+		{ID: Int64(1), Name: String("staging"), DeploymentBranchPolicy: &BranchPolicy{ProtectedBranches: Bool(false), CustomBranchPolicies: Bool(true)}},
+		{ID: Int64(2), Name: String("production"), ProtectionRules: []*ProtectionRule{{ID: Int64(4), Type: String("wait_timer"), WaitTimer: Int(30)}}},
+		{ID: Int64(3), Name: String("qa")},
+	}
+	if !reflect.DeepEqual(envs, want) {
+		t.Errorf("Repositories.ListEnvironmentsWithProtectionDetail returned %+v, want %+v", envs, want)
+	}
+}
@@ -53,7 +53,13 @@ func (s *RepositoriesService) ListForks(ctx context.Context, owner, repo string,
 // RepositoriesService.CreateFork method.
 type RepositoryCreateForkOptions struct {
 	// The organization to fork the repository into.
-	Organization string `url:"organization,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	// Name is a new name for the fork. Defaults to the name of the
+	// repository being forked.
+	Name string `json:"name,omitempty"`
+	// DefaultBranchOnly, when true, copies only the default branch into the
+	// fork, which makes forking large repositories faster.
+	DefaultBranchOnly bool `json:"default_branch_only,omitempty"`
 }
 
 // CreateFork creates a fork of the specified repository.
@@ -68,12 +74,12 @@ type RepositoryCreateForkOptions struct {
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-fork
 func (s *RepositoriesService) CreateFork(ctx context.Context, owner, repo string, opts *RepositoryCreateForkOptions) (*Repository, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/forks", owner, repo)
-	u, err := addOptions(u, opts)
-	if err != nil {
-		return nil, nil, err
-	}
 
-	req, err := s.client.NewRequest("POST", u, nil)
+	var body interface{}
+	if opts != nil {
+		body = opts
+	}
+	req, err := s.client.NewRequest("POST", u, body)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -56,7 +56,7 @@ func TestRepositoriesService_CreateFork(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testFormValues(t, r, values{"organization": "o"})
+		testBody(t, r, `{"organization":"o"}`+"\n")
 		fmt.Fprint(w, `{"id":1}`)
 	})
 
@@ -78,7 +78,7 @@ func TestRepositoriesService_CreateFork_deferred(t *testing.T) {
 
 	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testFormValues(t, r, values{"organization": "o"})
+		testBody(t, r, `{"organization":"o"}`+"\n")
 		// This response indicates the fork will happen asynchronously.
 		w.WriteHeader(http.StatusAccepted)
 		fmt.Fprint(w, `{"id":1}`)
@@ -96,6 +96,44 @@ func TestRepositoriesService_CreateFork_deferred(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CreateFork_defaultBranchOnly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"organization":"org","name":"r-fork","default_branch_only":true}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1,"name":"r-fork"}`)
+	})
+
+	opt := &RepositoryCreateForkOptions{Organization: "org", Name: "r-fork", DefaultBranchOnly: true}
+	repo, _, err := client.Repositories.CreateFork(context.Background(), "o", "r", opt)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Repositories.CreateFork returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Repository{ID: Int64(1), Name: String("r-fork")}
+	if !reflect.DeepEqual(repo, want) {
+		t.Errorf("Repositories.CreateFork returned %+v, want %+v", repo, want)
+	}
+}
+
+func TestRepositoriesService_CreateFork_noOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, "")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	if _, _, err := client.Repositories.CreateFork(context.Background(), "o", "r", nil); err != nil {
+		t.Errorf("Repositories.CreateFork returned error: %v", err)
+	}
+}
+
 func TestRepositoriesService_CreateFork_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -7,6 +7,7 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -76,9 +77,14 @@ type Hook struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 	URL       *string    `json:"url,omitempty"`
 	ID        *int64     `json:"id,omitempty"`
+	Type      *string    `json:"type,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	TestURL   *string    `json:"test_url,omitempty"`
+	PingURL   *string    `json:"ping_url,omitempty"`
 
 	// Only the following fields are used when creating a hook.
 	// Config is required.
+	// See TypedConfig and SetTypedConfig for the keys it commonly holds.
 	Config map[string]interface{} `json:"config,omitempty"`
 	Events []string               `json:"events,omitempty"`
 	Active *bool                  `json:"active,omitempty"`
@@ -88,6 +94,69 @@ func (h Hook) String() string {
 	return Stringify(h)
 }
 
+// TypedConfig returns the keys of h.Config that HookConfig models. An error
+// is returned if one of them does not hold a string.
+func (h *Hook) TypedConfig() (*HookConfig, error) {
+	c := new(HookConfig)
+	if h.Config == nil {
+		return c, nil
+	}
+	b, err := json.Marshal(h.Config)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("decoding hook config: %w", err)
+	}
+	return c, nil
+}
+
+// SetTypedConfig sets the non-nil fields of c in h.Config, creating it if
+// needed. The other keys of h.Config are kept.
+func (h *Hook) SetTypedConfig(c *HookConfig) {
+	if h.Config == nil {
+		h.Config = make(map[string]interface{})
+	}
+	if c == nil {
+		return
+	}
+	for key, v := range map[string]*string{
+		"url":          c.URL,
+		"content_type": c.ContentType,
+		"insecure_ssl": c.InsecureSSL,
+		"secret":       c.Secret,
+	} {
+		if v != nil {
+			h.Config[key] = *v
+		}
+	}
+}
+
+// HookConfig describes how the payloads of a Hook are delivered. It models
+// the common keys of Hook.Config; see Hook.TypedConfig and
+// Hook.SetTypedConfig.
+type HookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL *string `json:"url,omitempty"`
+	// ContentType is the media type used to serialize the payloads. Possible
+	// values are: json, form. Default is "form".
+	ContentType *string `json:"content_type,omitempty"`
+	// InsecureSSL determines whether the SSL certificate of the host for URL
+	// will be verified when delivering payloads. Possible values are: "0"
+	// (verification is performed), "1" (verification is not performed).
+	InsecureSSL *string `json:"insecure_ssl,omitempty"`
+	// Secret is used as the key to generate the HMAC hex digest sent in the
+	// X-Hub-Signature-256 header of deliveries. It is write-only: GitHub
+	// returns it obfuscated as "********", so the real secret must be set
+	// again when passing a fetched hook to EditHook, otherwise the
+	// obfuscated value would replace it.
+	Secret *string `json:"secret,omitempty"`
+}
+
+func (h HookConfig) String() string {
+	return Stringify(h)
+}
+
 // createHookRequest is a subset of Hook and is used internally
 // by CreateHook to pass only the known fields for the endpoint.
 //
@@ -156,6 +225,27 @@ func (s *RepositoriesService) ListHooks(ctx context.Context, owner, repo string,
 	return hooks, resp, nil
 }
 
+// ListHooksAll lists all Hooks for the specified repository, following
+// pagination until every page has been fetched. opts.Page is used as the
+// first page to fetch.
+//
+// If a request fails, the hooks fetched so far are returned along with the
+// error.
+func (s *RepositoriesService) ListHooksAll(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Hook, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Hook
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		hooks, resp, err := s.ListHooks(ctx, owner, repo, o)
+		all = append(all, hooks...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetHook returns a single specified Hook.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-repository-webhook
@@ -174,7 +264,9 @@ func (s *RepositoriesService) GetHook(ctx context.Context, owner, repo string, i
 	return h, resp, nil
 }
 
-// EditHook updates a specified Hook.
+// EditHook updates a specified Hook. Events replaces the list of events the
+// hook is triggered for, and a non-nil Config is sent as its new
+// configuration, so it should include the secret (see HookConfig.Secret).
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-repository-webhook
 func (s *RepositoriesService) EditHook(ctx context.Context, owner, repo string, id int64, hook *Hook) (*Hook, *Response, error) {
@@ -67,6 +67,107 @@ func TestRepositoriesService_ListHooks(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_CreateHook_config(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Hook{
+		Events: []string{"push", "pull_request"},
+		Active: Bool(true),
+	}
+	input.SetTypedConfig(&HookConfig{
+		URL:         String("https://example.com/hook"),
+		ContentType: String("json"),
+		InsecureSSL: String("0"),
+		Secret:      String("s3cret"),
+	})
+
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"web","config":{"content_type":"json","insecure_ssl":"0","secret":"s3cret","url":"https://example.com/hook"},"events":["push","pull_request"],"active":true}`+"\n")
+		fmt.Fprint(w, `{"id":1,"type":"Repository","name":"web","config":{"url":"https://example.com/hook","content_type":"json","insecure_ssl":"0","secret":"********"},"events":["push","pull_request"],"active":true}`)
+	})
+
+	hook, _, err := client.Repositories.CreateHook(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateHook returned error: %v", err)
+	}
+
+	if got, want := hook.GetType(), "Repository"; got != want {
+		t.Errorf("Repositories.CreateHook returned type %q, want %q", got, want)
+	}
+	if got, want := hook.GetName(), "web"; got != want {
+		t.Errorf("Repositories.CreateHook returned name %q, want %q", got, want)
+	}
+
+	config, err := hook.TypedConfig()
+	if err != nil {
+		t.Fatalf("Hook.TypedConfig returned error: %v", err)
+	}
+	want := &HookConfig{
+		URL:         String("https://example.com/hook"),
+		ContentType: String("json"),
+		InsecureSSL: String("0"),
+		Secret:      String("********"),
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("Hook.TypedConfig returned %+v, want %+v", config, want)
+	}
+}
+
+func TestHook_SetTypedConfig_keepsOtherKeys(t *testing.T) {
+	hook := &Hook{Config: map[string]interface{}{
+		"url":    "https://example.com/old",
+		"secret": "s3cret",
+		"room":   "#dev",
+	}}
+	hook.SetTypedConfig(&HookConfig{URL: String("https://example.com/new")})
+
+	want := map[string]interface{}{
+		"url":    "https://example.com/new",
+		"secret": "s3cret",
+		"room":   "#dev",
+	}
+	if !reflect.DeepEqual(hook.Config, want) {
+		t.Errorf("Hook.Config = %+v, want %+v", hook.Config, want)
+	}
+}
+
+func TestHook_TypedConfig_invalid(t *testing.T) {
+	hook := &Hook{Config: map[string]interface{}{"url": 1}}
+	if _, err := hook.TypedConfig(); err == nil {
+		t.Error("Hook.TypedConfig returned no error for a non-string url")
+	}
+}
+
+func TestRepositoriesService_ListHooksAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/hooks?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	hooks, _, err := client.Repositories.ListHooksAll(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Repositories.ListHooksAll returned error: %v", err)
+	}
+
+	want := []*Hook{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(hooks, want) {
+		t.Errorf("Repositories.ListHooksAll returned %+v, want %+v", hooks, want)
+	}
+}
+
 func TestRepositoriesService_ListHooks_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -132,6 +233,29 @@ func TestRepositoriesService_EditHook(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_EditHook_events(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Hook{Events: []string{"push", "release"}}
+
+	mux.HandleFunc("/repos/o/r/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"events":["push","release"]}`+"\n")
+		fmt.Fprint(w, `{"id":1,"events":["push","release"],"active":true}`)
+	})
+
+	hook, _, err := client.Repositories.EditHook(context.Background(), "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.EditHook returned error: %v", err)
+	}
+
+	want := &Hook{ID: Int64(1), Events: []string{"push", "release"}, Active: Bool(true)}
+	if !reflect.DeepEqual(hook, want) {
+		t.Errorf("Repositories.EditHook returned %+v, want %+v", hook, want)
+	}
+}
+
 func TestRepositoriesService_EditHook_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -36,6 +36,27 @@ func (s *RepositoriesService) ListKeys(ctx context.Context, owner string, repo s
 	return keys, resp, nil
 }
 
+// ListKeysAll lists all the deploy keys for a repository, following
+// pagination until every page has been fetched. opts.Page is used as the
+// first page to fetch. Key.LastUsed can be used to find stale deploy keys.
+//
+// If a request fails, the keys fetched so far are returned along with the
+// error.
+func (s *RepositoriesService) ListKeysAll(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Key, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*Key
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		keys, resp, err := s.ListKeys(ctx, owner, repo, o)
+		all = append(all, keys...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetKey fetches a single deploy key.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-deploy-key
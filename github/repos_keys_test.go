@@ -36,6 +36,34 @@ func TestRepositoriesService_ListKeys(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListKeysAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/keys?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	keys, _, err := client.Repositories.ListKeysAll(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Repositories.ListKeysAll returned error: %v", err)
+	}
+
+	want := []*Key{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("Repositories.ListKeysAll returned %+v, want %+v", keys, want)
+	}
+}
+
 func TestRepositoriesService_ListKeys_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -44,6 +72,34 @@ func TestRepositoriesService_ListKeys_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_GetKey_lastUsed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/keys/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"title":"deploy","read_only":true,"verified":true,"created_at":`+referenceTimeStr+`,"added_by":"octocat","last_used":`+referenceTimeStr+`}`)
+	})
+
+	key, _, err := client.Repositories.GetKey(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetKey returned error: %v", err)
+	}
+
+	want := &Key{
+		ID:        Int64(1),
+		Title:     String("deploy"),
+		ReadOnly:  Bool(true),
+		Verified:  Bool(true),
+		CreatedAt: &Timestamp{referenceTime},
+		AddedBy:   String("octocat"),
+		LastUsed:  &Timestamp{referenceTime},
+	}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Repositories.GetKey returned %+v, want %+v", key, want)
+	}
+}
+
 func TestRepositoriesService_GetKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // RepositoryMergeRequest represents a request to merge a branch in a
@@ -18,8 +20,57 @@ type RepositoryMergeRequest struct {
 	CommitMessage *string `json:"commit_message,omitempty"`
 }
 
+// MergeConflictError is returned by RepositoriesService.Merge when the head
+// cannot be merged into the base automatically because of a merge conflict.
+type MergeConflictError struct {
+	Base string // base branch of the attempted merge
+	Head string // head branch, tag or SHA of the attempted merge
+
+	// ErrorResponse is the underlying 409 response returned by GitHub.
+	ErrorResponse *ErrorResponse
+}
+
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("merge conflict merging %q into %q: %v", e.Head, e.Base, e.ErrorResponse)
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *MergeConflictError) Unwrap() error { return e.ErrorResponse }
+
+// MergeRefNotFoundError is returned by RepositoriesService.Merge when the
+// base or the head of the merge does not exist.
+type MergeRefNotFoundError struct {
+	// Missing is "base" or "head", depending on which of them does not
+	// exist, or empty if GitHub did not say.
+	Missing string
+	Base    string // base branch of the attempted merge
+	Head    string // head branch, tag or SHA of the attempted merge
+
+	// ErrorResponse is the underlying 404 response returned by GitHub.
+	ErrorResponse *ErrorResponse
+}
+
+func (e *MergeRefNotFoundError) Error() string {
+	switch e.Missing {
+	case "base":
+		return fmt.Sprintf("merge base %q does not exist: %v", e.Base, e.ErrorResponse)
+	case "head":
+		return fmt.Sprintf("merge head %q does not exist: %v", e.Head, e.ErrorResponse)
+	default:
+		return fmt.Sprintf("merge base %q or head %q does not exist: %v", e.Base, e.Head, e.ErrorResponse)
+	}
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *MergeRefNotFoundError) Unwrap() error { return e.ErrorResponse }
+
 // Merge a branch in the specified repository.
 //
+// On success, the merge commit is returned. If the base already contains the
+// head, there is nothing to merge: GitHub responds with 204 No Content and the
+// returned commit is nil. A merge conflict is reported as a *MergeConflictError,
+// and a missing base or head as a *MergeRefNotFoundError.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#merge-a-branch
 func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, request *RepositoryMergeRequest) (*RepositoryCommit, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/merges", owner, repo)
@@ -31,8 +82,34 @@ func (s *RepositoriesService) Merge(ctx context.Context, owner, repo string, req
 	commit := new(RepositoryCommit)
 	resp, err := s.client.Do(ctx, req, commit)
 	if err != nil {
+		if errResp, ok := err.(*ErrorResponse); ok {
+			switch errResp.Response.StatusCode {
+			case http.StatusConflict:
+				err = &MergeConflictError{
+					Base:          request.GetBase(),
+					Head:          request.GetHead(),
+					ErrorResponse: errResp,
+				}
+			case http.StatusNotFound:
+				notFound := &MergeRefNotFoundError{
+					Base:          request.GetBase(),
+					Head:          request.GetHead(),
+					ErrorResponse: errResp,
+				}
+				switch msg := strings.ToLower(errResp.Message); {
+				case strings.HasPrefix(msg, "base"):
+					notFound.Missing = "base"
+				case strings.HasPrefix(msg, "head"):
+					notFound.Missing = "head"
+				}
+				err = notFound
+			}
+		}
 		return nil, resp, err
 	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
 
 	return commit, resp, nil
 }
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -46,3 +47,71 @@ func TestRepositoriesService_Merge(t *testing.T) {
 		t.Errorf("Repositories.Merge returned %+v, want %+v", commit, want)
 	}
 }
+
+func TestRepositoriesService_Merge_nothingToMerge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	commit, resp, err := client.Repositories.Merge(context.Background(), "o", "r", &RepositoryMergeRequest{Base: String("b"), Head: String("h")})
+	if err != nil {
+		t.Errorf("Repositories.Merge returned error: %v", err)
+	}
+	if commit != nil {
+		t.Errorf("Repositories.Merge returned %+v, want nil", commit)
+	}
+	if got, want := resp.StatusCode, http.StatusNoContent; got != want {
+		t.Errorf("Repositories.Merge returned status %d, want %d", got, want)
+	}
+}
+
+func TestRepositoriesService_Merge_conflict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Merge Conflict"}`)
+	})
+
+	_, _, err := client.Repositories.Merge(context.Background(), "o", "r", &RepositoryMergeRequest{Base: String("b"), Head: String("h")})
+	var conflict *MergeConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Repositories.Merge returned error %#v, want *MergeConflictError", err)
+	}
+	if conflict.Base != "b" || conflict.Head != "h" {
+		t.Errorf("MergeConflictError has base %q and head %q, want %q and %q", conflict.Base, conflict.Head, "b", "h")
+	}
+	if got, want := conflict.ErrorResponse.Message, "Merge Conflict"; got != want {
+		t.Errorf("MergeConflictError message is %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_Merge_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/merges", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Head does not exist"}`)
+	})
+
+	_, _, err := client.Repositories.Merge(context.Background(), "o", "r", &RepositoryMergeRequest{Base: String("b"), Head: String("h")})
+	var notFound *MergeRefNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Repositories.Merge returned error %#v, want *MergeRefNotFoundError", err)
+	}
+	if got, want := notFound.Missing, "head"; got != want {
+		t.Errorf("MergeRefNotFoundError.Missing is %q, want %q", got, want)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Error("MergeRefNotFoundError does not unwrap to *ErrorResponse")
+	}
+}
@@ -18,6 +18,12 @@ type Pages struct {
 	Custom404 *bool        `json:"custom_404,omitempty"`
 	HTMLURL   *string      `json:"html_url,omitempty"`
 	Source    *PagesSource `json:"source,omitempty"`
+	// BuildType is the process in which the site is built. Possible values
+	// are: legacy (built by GitHub from Source) and workflow (built and
+	// deployed by a GitHub Actions workflow).
+	BuildType     *string `json:"build_type,omitempty"`
+	HTTPSEnforced *bool   `json:"https_enforced,omitempty"`
+	Public        *bool   `json:"public,omitempty"`
 }
 
 // PagesSource represents a GitHub page's source.
@@ -46,17 +52,21 @@ type PagesBuild struct {
 // createPagesRequest is a subset of Pages and is used internally
 // by EnablePages to pass only the known fields for the endpoint.
 type createPagesRequest struct {
-	Source *PagesSource `json:"source,omitempty"`
+	BuildType *string      `json:"build_type,omitempty"`
+	Source    *PagesSource `json:"source,omitempty"`
 }
 
-// EnablePages enables GitHub Pages for the named repo.
+// EnablePages enables GitHub Pages for the named repo. Only the Source and
+// BuildType fields of pages are used. Source is required unless BuildType
+// is "workflow".
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-github-pages-site
 func (s *RepositoriesService) EnablePages(ctx context.Context, owner, repo string, pages *Pages) (*Pages, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/pages", owner, repo)
 
 	pagesReq := &createPagesRequest{
-		Source: pages.Source,
+		BuildType: pages.BuildType,
+		Source:    pages.Source,
 	}
 
 	req, err := s.client.NewRequest("POST", u, pagesReq)
@@ -83,6 +93,11 @@ type PagesUpdate struct {
 	// Source must include the branch name, and may optionally specify the subdirectory "/docs".
 	// Possible values are: "gh-pages", "master", and "master /docs".
 	Source *string `json:"source,omitempty"`
+	// BuildType is the process by which the site is built. Possible values
+	// are: legacy and workflow. Source is ignored for the workflow build type.
+	BuildType *string `json:"build_type,omitempty"`
+	// HTTPSEnforced specifies whether HTTPS should be enforced for the site.
+	HTTPSEnforced *bool `json:"https_enforced,omitempty"`
 }
 
 // UpdatePages updates GitHub Pages for the named repo.
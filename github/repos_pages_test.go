@@ -54,6 +54,58 @@ func TestRepositoriesService_EnablePages(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_EnablePages_workflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Pages{BuildType: String("workflow")}
+
+	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"build_type":"workflow"}`+"\n")
+		fmt.Fprint(w, `{"url":"u","status":"built","build_type":"workflow","https_enforced":true,"public":true,"source":{"branch":"main","path":"/"}}`)
+	})
+
+	page, _, err := client.Repositories.EnablePages(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.EnablePages returned error: %v", err)
+	}
+
+	want := &Pages{
+		URL:           String("u"),
+		Status:        String("built"),
+		BuildType:     String("workflow"),
+		HTTPSEnforced: Bool(true),
+		Public:        Bool(true),
+		Source:        &PagesSource{Branch: String("main"), Path: String("/")},
+	}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("Repositories.EnablePages returned %v, want %v", page, want)
+	}
+}
+
+func TestRepositoriesService_UpdatePages_buildType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &PagesUpdate{
+		CNAME:         String("www.my-domain.com"),
+		BuildType:     String("workflow"),
+		HTTPSEnforced: Bool(true),
+	}
+
+	mux.HandleFunc("/repos/o/r/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"cname":"www.my-domain.com","build_type":"workflow","https_enforced":true}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Repositories.UpdatePages(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdatePages returned error: %v", err)
+	}
+}
+
 func TestRepositoriesService_UpdatePages(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -10,11 +10,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"mime"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // RepositoryRelease represents a GitHub release in a repository.
@@ -89,6 +92,65 @@ func (s *RepositoriesService) ListReleases(ctx context.Context, owner, repo stri
 	return releases, resp, nil
 }
 
+// ReleaseFilterOptions specifies the optional parameters to the
+// RepositoriesService.ListStableReleases and
+// RepositoriesService.ListPrereleases methods.
+type ReleaseFilterOptions struct {
+	// IncludeDrafts includes draft releases of the requested kind in the
+	// results. Drafts are only visible to users with push access to the
+	// repository, and are excluded by default.
+	IncludeDrafts bool
+
+	ListOptions
+}
+
+// ListStableReleases lists the releases for a repository that are not
+// prereleases, following pagination until every page has been fetched.
+// Draft releases are excluded unless opts.IncludeDrafts is set.
+//
+// GitHub has no server-side filter for this, so every release is fetched and
+// the filtering is done client-side. If a request fails, the matching
+// releases fetched so far are returned along with the error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-releases
+func (s *RepositoriesService) ListStableReleases(ctx context.Context, owner, repo string, opts *ReleaseFilterOptions) ([]*RepositoryRelease, *Response, error) {
+	return s.listReleasesWhere(ctx, owner, repo, opts, false)
+}
+
+// ListPrereleases lists the releases for a repository that are marked as
+// prereleases, following pagination until every page has been fetched.
+// Draft releases are excluded unless opts.IncludeDrafts is set.
+//
+// GitHub has no server-side filter for this, so every release is fetched and
+// the filtering is done client-side. If a request fails, the matching
+// releases fetched so far are returned along with the error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-releases
+func (s *RepositoriesService) ListPrereleases(ctx context.Context, owner, repo string, opts *ReleaseFilterOptions) ([]*RepositoryRelease, *Response, error) {
+	return s.listReleasesWhere(ctx, owner, repo, opts, true)
+}
+
+// listReleasesWhere lists all the releases for a repository whose Prerelease
+// flag equals prerelease, skipping drafts unless opts.IncludeDrafts is set.
+func (s *RepositoriesService) listReleasesWhere(ctx context.Context, owner, repo string, opts *ReleaseFilterOptions, prerelease bool) ([]*RepositoryRelease, *Response, error) {
+	o := new(ReleaseFilterOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var matching []*RepositoryRelease
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		releases, resp, err := s.ListReleases(ctx, owner, repo, &o.ListOptions)
+		for _, r := range releases {
+			if r.GetPrerelease() == prerelease && (o.IncludeDrafts || !r.GetDraft()) {
+				matching = append(matching, r)
+			}
+		}
+		return resp, err
+	})
+	return matching, resp, err
+}
+
 // GetRelease fetches a single release.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-release
@@ -105,12 +167,36 @@ func (s *RepositoriesService) GetLatestRelease(ctx context.Context, owner, repo
 	return s.getSingleRelease(ctx, u)
 }
 
+// ReleaseNotFoundError is returned by GetReleaseByTag when the repository has
+// no published release for the requested tag. Draft releases are not
+// associated with a tag until they are published, so they are never found
+// by GetReleaseByTag; use ListReleases to find them instead.
+type ReleaseNotFoundError struct {
+	Tag string // tag that was looked up
+
+	// ErrorResponse is the underlying 404 response returned by GitHub.
+	ErrorResponse *ErrorResponse
+}
+
+func (e *ReleaseNotFoundError) Error() string {
+	return fmt.Sprintf("no release found for tag %q: %v", e.Tag, e.ErrorResponse)
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *ReleaseNotFoundError) Unwrap() error { return e.ErrorResponse }
+
 // GetReleaseByTag fetches a release with the specified tag.
+// If no published release exists for tag, the returned error is
+// a *ReleaseNotFoundError.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-release-by-tag-name
 func (s *RepositoriesService) GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*RepositoryRelease, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/releases/tags/%s", owner, repo, tag)
-	return s.getSingleRelease(ctx, u)
+	release, resp, err := s.getSingleRelease(ctx, u)
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+		return nil, resp, &ReleaseNotFoundError{Tag: tag, ErrorResponse: errResp}
+	}
+	return release, resp, err
 }
 
 func (s *RepositoriesService) getSingleRelease(ctx context.Context, url string) (*RepositoryRelease, *Response, error) {
@@ -331,6 +417,97 @@ func (s *RepositoriesService) downloadReleaseAssetFromURL(ctx context.Context, f
 	return resp.Body, nil
 }
 
+// ErrNoMatchingAssets is returned, wrapped, by DownloadReleaseAssetsMatching
+// when no asset of the release matches the pattern.
+var ErrNoMatchingAssets = errors.New("no release assets match the pattern")
+
+// ReleaseAssetDownload is the result of downloading one release asset with
+// RepositoriesService.DownloadReleaseAssetsMatching.
+type ReleaseAssetDownload struct {
+	Asset *ReleaseAsset
+	// Path is the file the asset was written to. It is empty if the
+	// download failed.
+	Path string
+}
+
+// DownloadReleaseAssetsMatching downloads the assets of a release whose name
+// matches pattern into the directory dir, running a few downloads
+// concurrently. pattern uses the syntax of path.Match, such as
+// "*-linux-amd64.tar.gz". Each asset is written to a file named after it,
+// replacing any existing file; it is first written to a temporary file in
+// dir so that a failed download never leaves a partial file behind.
+//
+// One result is returned per matching asset, in the order the release lists
+// them. If no asset matches, an error wrapping ErrNoMatchingAssets is
+// returned. If some downloads fail, a *BatchError keyed by asset name is
+// returned along with the results. Assets are downloaded with
+// DownloadReleaseAsset, following redirects with http.DefaultClient.
+func (s *RepositoriesService) DownloadReleaseAssetsMatching(ctx context.Context, owner, repo string, releaseID int64, pattern, dir string) ([]*ReleaseAssetDownload, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid asset pattern %q: %w", pattern, err)
+	}
+
+	opts := new(ListOptions)
+	var assets []*ReleaseAsset
+	_, err := paginate(s.client.maxPages, opts, func() (*Response, error) {
+		page, resp, err := s.ListReleaseAssets(ctx, owner, repo, releaseID, opts)
+		assets = append(assets, page...)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var downloads []*ReleaseAssetDownload
+	var names []string
+	for _, asset := range assets {
+		if ok, _ := path.Match(pattern, asset.GetName()); ok {
+			downloads = append(downloads, &ReleaseAssetDownload{Asset: asset})
+			names = append(names, asset.GetName())
+		}
+	}
+	if len(downloads) == 0 {
+		return nil, fmt.Errorf("release %d of %v/%v: %w: %q", releaseID, owner, repo, ErrNoMatchingAssets, pattern)
+	}
+
+	err = forEachConcurrently(ctx, names, func(i int) error {
+		d := downloads[i]
+		rc, _, err := s.DownloadReleaseAsset(ctx, owner, repo, d.Asset.GetID(), http.DefaultClient)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		p := filepath.Join(dir, filepath.Base(names[i]))
+		if err := writeFileAtomically(p, rc); err != nil {
+			return err
+		}
+		d.Path = p
+		return nil
+	})
+	return downloads, err
+}
+
+// writeFileAtomically writes the contents of r to the file name, through a
+// temporary file in the same directory that is renamed once complete.
+func writeFileAtomically(name string, r io.Reader) error {
+	f, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
 // EditReleaseAsset edits a repository release asset.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-release-asset
@@ -399,3 +576,108 @@ func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, rep
 	}
 	return asset, resp, nil
 }
+
+// releaseAssetRollbackTimeout bounds the deletion of the assets uploaded by
+// RepositoriesService.UploadReleaseAssets when rolling back.
+const releaseAssetRollbackTimeout = time.Minute
+
+// AssetUpload describes a release asset to upload with
+// RepositoriesService.UploadReleaseAssets.
+type AssetUpload struct {
+	// Name is the file name of the asset. It is required.
+	Name  string
+	Label string
+	// MediaType is the media type of the asset. If empty, it is inferred
+	// from the extension of Name.
+	MediaType string
+
+	// Content is read to upload the asset; Size is its length in bytes.
+	Content io.Reader
+	Size    int64
+}
+
+// UploadReleaseAssets uploads several assets into a release, running a few
+// uploads concurrently. The returned slice holds the uploaded asset for each
+// element of assets, at the same index, or nil if that upload failed.
+//
+// If any upload fails, a *BatchError is returned, keyed by the asset name
+// followed by "#" and its index in assets, such as "app.zip#2", so that
+// assets sharing a name are told apart. When rollback is true, the assets
+// that were uploaded successfully are then deleted again so that the release
+// is left as it was; their entries in the returned slice are set to nil once
+// deleted, and failed deletions are added to the *BatchError under the key of
+// the asset suffixed with " (rollback)". The deletions run on a context of
+// their own, limited to one minute, so that they still run when the uploads
+// failed because ctx was canceled or timed out.
+//
+// The assets are checked before any upload starts: an error naming the
+// index of the first nil asset or asset without a name is returned, and
+// nothing is uploaded.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#upload-a-release-asset
+func (s *RepositoriesService) UploadReleaseAssets(ctx context.Context, owner, repo string, releaseID int64, assets []*AssetUpload, rollback bool) ([]*ReleaseAsset, error) {
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, releaseID)
+	keys := make([]string, len(assets))
+	for i, a := range assets {
+		if a == nil {
+			return nil, fmt.Errorf("assets[%d] is nil", i)
+		}
+		if a.Name == "" {
+			return nil, fmt.Errorf("assets[%d] has no name", i)
+		}
+		keys[i] = fmt.Sprintf("%v#%d", a.Name, i)
+	}
+
+	uploaded := make([]*ReleaseAsset, len(assets))
+	err := forEachConcurrently(ctx, keys, func(i int) error {
+		a := assets[i]
+		u, err := addOptions(u, &UploadOptions{Name: a.Name, Label: a.Label})
+		if err != nil {
+			return err
+		}
+
+		mediaType := a.MediaType
+		if mediaType == "" {
+			mediaType = mime.TypeByExtension(filepath.Ext(a.Name))
+		}
+		req, err := s.client.NewUploadRequest(u, a.Content, a.Size, mediaType)
+		if err != nil {
+			return err
+		}
+
+		asset := new(ReleaseAsset)
+		if _, err := s.client.Do(ctx, req, asset); err != nil {
+			return err
+		}
+		uploaded[i] = asset
+		return nil
+	})
+	if err == nil || !rollback {
+		return uploaded, err
+	}
+
+	var done []int
+	var rollbackKeys []string
+	for i, asset := range uploaded {
+		if asset != nil {
+			done = append(done, i)
+			rollbackKeys = append(rollbackKeys, keys[i]+" (rollback)")
+		}
+	}
+	rollbackCtx, cancel := context.WithTimeout(context.Background(), releaseAssetRollbackTimeout)
+	defer cancel()
+	rollbackErr := forEachConcurrently(rollbackCtx, rollbackKeys, func(j int) error {
+		i := done[j]
+		if _, err := s.DeleteReleaseAsset(rollbackCtx, owner, repo, uploaded[i].GetID()); err != nil {
+			return err
+		}
+		uploaded[i] = nil
+		return nil
+	})
+	if rollbackErr, ok := rollbackErr.(*BatchError); ok {
+		for k, e := range rollbackErr.Errors {
+			err.(*BatchError).Errors[k] = e
+		}
+	}
+	return uploaded, err
+}
@@ -9,10 +9,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -39,6 +41,53 @@ func TestRepositoriesService_ListReleases(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListStableReleasesAndPrereleases(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/releases?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1,"prerelease":false},{"id":2,"prerelease":true},{"id":3,"draft":true,"prerelease":false}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":4,"prerelease":true,"draft":true},{"id":5}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	ids := func(releases []*RepositoryRelease) []int64 {
+		var ids []int64
+		for _, r := range releases {
+			ids = append(ids, r.GetID())
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name string
+		list func(context.Context, string, string, *ReleaseFilterOptions) ([]*RepositoryRelease, *Response, error)
+		opts *ReleaseFilterOptions
+		want []int64
+	}{
+		{"ListStableReleases", client.Repositories.ListStableReleases, nil, []int64{1, 5}},
+		{"ListStableReleases with drafts", client.Repositories.ListStableReleases, &ReleaseFilterOptions{IncludeDrafts: true}, []int64{1, 3, 5}},
+		{"ListPrereleases", client.Repositories.ListPrereleases, nil, []int64{2}},
+		{"ListPrereleases with drafts", client.Repositories.ListPrereleases, &ReleaseFilterOptions{IncludeDrafts: true}, []int64{2, 4}},
+	}
+	for _, tt := range tests {
+		releases, _, err := tt.list(context.Background(), "o", "r", tt.opts)
+		if err != nil {
+			t.Errorf("%v returned error: %v", tt.name, err)
+		}
+		if got := ids(releases); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v returned releases %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestRepositoriesService_GetRelease(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -99,6 +148,86 @@ func TestRepositoriesService_GetReleaseByTag(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetReleaseByTag_fullRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"tag_name": "v1.0.0",
+			"name": "v1.0.0",
+			"draft": false,
+			"prerelease": true,
+			"created_at": `+referenceTimeStr+`,
+			"published_at": `+referenceTimeStr+`,
+			"author": {"login": "octocat", "id": 1},
+			"assets": [
+				{"id": 2, "name": "a.tar.gz", "state": "uploaded", "size": 1024, "download_count": 3}
+			]
+		}`)
+	})
+
+	release, _, err := client.Repositories.GetReleaseByTag(context.Background(), "o", "r", "v1.0.0")
+	if err != nil {
+		t.Fatalf("Repositories.GetReleaseByTag returned error: %v", err)
+	}
+
+	want := &RepositoryRelease{
+		ID:          Int64(1),
+		TagName:     String("v1.0.0"),
+		Name:        String("v1.0.0"),
+		Draft:       Bool(false),
+		Prerelease:  Bool(true),
+		CreatedAt:   &Timestamp{referenceTime},
+		PublishedAt: &Timestamp{referenceTime},
+		Author:      &User{Login: String("octocat"), ID: Int64(1)},
+		Assets: []*ReleaseAsset{
+			{
+				ID:            Int64(2),
+				Name:          String("a.tar.gz"),
+				State:         String("uploaded"),
+				Size:          Int(1024),
+				DownloadCount: Int(3),
+			},
+		},
+	}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.GetReleaseByTag returned %+v, want %+v", release, want)
+	}
+}
+
+func TestRepositoriesService_GetReleaseByTag_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/tags/v2.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message": "Not Found"}`, http.StatusNotFound)
+	})
+
+	release, resp, err := client.Repositories.GetReleaseByTag(context.Background(), "o", "r", "v2.0.0")
+	if release != nil {
+		t.Errorf("Repositories.GetReleaseByTag returned %+v, want nil", release)
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Repositories.GetReleaseByTag returned status %d, want %d", got, want)
+	}
+
+	var notFound *ReleaseNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Repositories.GetReleaseByTag returned error %#v, want *ReleaseNotFoundError", err)
+	}
+	if got, want := notFound.Tag, "v2.0.0"; got != want {
+		t.Errorf("ReleaseNotFoundError.Tag is %q, want %q", got, want)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("ReleaseNotFoundError does not unwrap to *ErrorResponse")
+	}
+}
+
 func TestRepositoriesService_CreateRelease(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -342,6 +471,82 @@ func TestRepositoriesService_DownloadReleaseAsset_APIError(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_DownloadReleaseAssetsMatching(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id":1,"name":"tool-linux-amd64.tar.gz"},
+			{"id":2,"name":"tool-darwin-amd64.tar.gz"},
+			{"id":3,"name":"tool-linux-amd64.tar.gz.sha256"},
+			{"id":4,"name":"lib-linux-amd64.tar.gz"}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", defaultMediaType)
+		fmt.Fprint(w, "tool")
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/4", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	for _, id := range []string{"2", "3"} {
+		mux.HandleFunc("/repos/o/r/releases/assets/"+id, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("downloaded non-matching asset %v", r.URL.Path)
+		})
+	}
+
+	dir, err := ioutil.TempDir("", "assets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	downloads, err := client.Repositories.DownloadReleaseAssetsMatching(context.Background(), "o", "r", 1, "*-linux-amd64.tar.gz", dir)
+
+	toolPath := filepath.Join(dir, "tool-linux-amd64.tar.gz")
+	want := []*ReleaseAssetDownload{
+		{Asset: &ReleaseAsset{ID: Int64(1), Name: String("tool-linux-amd64.tar.gz")}, Path: toolPath},
+		{Asset: &ReleaseAsset{ID: Int64(4), Name: String("lib-linux-amd64.tar.gz")}},
+	}
+	if !reflect.DeepEqual(downloads, want) {
+		t.Errorf("Repositories.DownloadReleaseAssetsMatching returned %+v, want %+v", downloads, want)
+	}
+	be, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.DownloadReleaseAssetsMatching returned error %#v, want *BatchError", err)
+	}
+	if len(be.Errors) != 1 || be.Errors["lib-linux-amd64.tar.gz"] == nil {
+		t.Errorf("BatchError.Errors = %v, want an error for lib-linux-amd64.tar.gz", be.Errors)
+	}
+
+	if content, err := ioutil.ReadFile(toolPath); err != nil || string(content) != "tool" {
+		t.Errorf("downloaded file contains %q (error %v), want %q", content, err, "tool")
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("download directory holds %v files, want only the downloaded asset", len(files))
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetsMatching_noMatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1,"name":"tool-linux-amd64.tar.gz"}]`)
+	})
+
+	_, err := client.Repositories.DownloadReleaseAssetsMatching(context.Background(), "o", "r", 1, "*-windows-*.zip", os.TempDir())
+	if !errors.Is(err, ErrNoMatchingAssets) {
+		t.Errorf("Repositories.DownloadReleaseAssetsMatching returned error %v, want ErrNoMatchingAssets", err)
+	}
+}
+
 func TestRepositoriesService_EditReleaseAsset(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -470,3 +675,193 @@ func TestRepositoriesService_UploadReleaseAsset(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoriesService_UploadReleaseAssets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, _ := ioutil.ReadAll(r.Body)
+		switch name := r.FormValue("name"); name {
+		case "a.txt":
+			testHeader(t, r, "Content-Type", "text/plain; charset=utf-8")
+			if string(body) != "aaa" {
+				t.Errorf("asset a.txt has body %q, want %q", body, "aaa")
+			}
+			fmt.Fprint(w, `{"id":10,"name":"a.txt"}`)
+		case "b":
+			testHeader(t, r, "Content-Type", "application/zip")
+			testFormValues(t, r, values{"name": "b", "label": "B"})
+			fmt.Fprint(w, `{"id":11,"name":"b"}`)
+		default:
+			t.Errorf("unexpected asset %q", name)
+		}
+	})
+
+	assets := []*AssetUpload{
+		{Name: "a.txt", Content: strings.NewReader("aaa"), Size: 3},
+		{Name: "b", Label: "B", MediaType: "application/zip", Content: strings.NewReader("bb"), Size: 2},
+	}
+	got, err := client.Repositories.UploadReleaseAssets(context.Background(), "o", "r", 1, assets, true)
+	if err != nil {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error: %v", err)
+	}
+
+	want := []*ReleaseAsset{{ID: Int64(10), Name: String("a.txt")}, {ID: Int64(11), Name: String("b")}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.UploadReleaseAssets returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssets_rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("name") == "bad" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Validation Failed"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":10,"name":"good"}`)
+	})
+	deleted := false
+	mux.HandleFunc("/repos/o/r/releases/assets/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assets := []*AssetUpload{
+		{Name: "good", Content: strings.NewReader("g"), Size: 1},
+		{Name: "bad", Content: strings.NewReader("b"), Size: 1},
+	}
+	got, err := client.Repositories.UploadReleaseAssets(context.Background(), "o", "r", 1, assets, true)
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error %#v, want *BatchError", err)
+	}
+	if _, ok := batchErr.Errors["bad#1"]; !ok || len(batchErr.Errors) != 1 {
+		t.Errorf("BatchError.Errors is %v, want only bad#1", batchErr.Errors)
+	}
+	if !deleted {
+		t.Error("Repositories.UploadReleaseAssets did not delete the uploaded asset")
+	}
+	if want := []*ReleaseAsset{nil, nil}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.UploadReleaseAssets returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssets_duplicateNames(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Validation Failed"}`)
+	})
+
+	assets := []*AssetUpload{
+		{Name: "dup", Content: strings.NewReader("1"), Size: 1},
+		{Name: "dup", Content: strings.NewReader("2"), Size: 1},
+	}
+	_, err := client.Repositories.UploadReleaseAssets(context.Background(), "o", "r", 1, assets, false)
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error %#v, want *BatchError", err)
+	}
+	if len(batchErr.Errors) != 2 || batchErr.Errors["dup#0"] == nil || batchErr.Errors["dup#1"] == nil {
+		t.Errorf("BatchError.Errors is %v, want dup#0 and dup#1", batchErr.Errors)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssets_invalidAsset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("uploaded asset %q despite an invalid asset", r.FormValue("name"))
+	})
+
+	tests := []struct {
+		assets  []*AssetUpload
+		wantErr string
+	}{
+		{[]*AssetUpload{{Name: "a", Content: strings.NewReader("1"), Size: 1}, nil}, "assets[1] is nil"},
+		{[]*AssetUpload{{Content: strings.NewReader("1"), Size: 1}}, "assets[0] has no name"},
+	}
+	for _, tt := range tests {
+		uploaded, err := client.Repositories.UploadReleaseAssets(context.Background(), "o", "r", 1, tt.assets, false)
+		if err == nil || err.Error() != tt.wantErr {
+			t.Errorf("Repositories.UploadReleaseAssets returned error %v, want %q", err, tt.wantErr)
+		}
+		if uploaded != nil {
+			t.Errorf("Repositories.UploadReleaseAssets returned %v, want nil", uploaded)
+		}
+	}
+}
+
+// cancelingTransport cancels a context once it has received the response to
+// a request whose URL contains trigger.
+type cancelingTransport struct {
+	trigger string
+	cancel  context.CancelFunc
+}
+
+func (t *cancelingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || !strings.Contains(req.URL.String(), t.trigger) {
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	t.cancel()
+	return resp, nil
+}
+
+func TestRepositoriesService_UploadReleaseAssets_rollbackAfterCancel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client.client.Transport = &cancelingTransport{trigger: "name=good", cancel: cancel}
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("name") == "slow" {
+			// Answer only once the upload of good has canceled ctx.
+			<-ctx.Done()
+		}
+		fmt.Fprint(w, `{"id":10,"name":"good"}`)
+	})
+	deleted := false
+	mux.HandleFunc("/repos/o/r/releases/assets/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assets := []*AssetUpload{
+		{Name: "good", Content: strings.NewReader("g"), Size: 1},
+		{Name: "slow", Content: strings.NewReader("s"), Size: 1},
+	}
+	got, err := client.Repositories.UploadReleaseAssets(ctx, "o", "r", 1, assets, true)
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.UploadReleaseAssets returned error %#v, want *BatchError", err)
+	}
+	if err := batchErr.Errors["slow#1"]; err != context.Canceled || len(batchErr.Errors) != 1 {
+		t.Errorf("BatchError.Errors is %v, want only slow#1 canceled", batchErr.Errors)
+	}
+	if !deleted {
+		t.Error("Repositories.UploadReleaseAssets did not delete the uploaded asset")
+	}
+	if want := []*ReleaseAsset{nil, nil}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.UploadReleaseAssets returned %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RuleSuite represents the evaluation of the rulesets that apply to a repository
+// for a single push.
+type RuleSuite struct {
+	ID        *int64     `json:"id,omitempty"`
+	ActorID   *int64     `json:"actor_id,omitempty"`
+	ActorName *string    `json:"actor_name,omitempty"`
+	BeforeSHA *string    `json:"before_sha,omitempty"`
+	AfterSHA  *string    `json:"after_sha,omitempty"`
+	Ref       *string    `json:"ref,omitempty"`
+	PushedAt  *Timestamp `json:"pushed_at,omitempty"`
+
+	RepositoryID   *int64  `json:"repository_id,omitempty"`
+	RepositoryName *string `json:"repository_name,omitempty"`
+
+	// Result is the result of the rule evaluations for rules with the "active"
+	// enforcement status. Possible values are: pass, fail, bypass.
+	Result *string `json:"result,omitempty"`
+	// EvaluationResult is the result of the rule evaluations for rules with
+	// the "active" and "evaluate" enforcement statuses, demonstrating whether
+	// rules would pass or fail if all rules in the rule suite were active.
+	// Possible values are: pass, fail.
+	EvaluationResult *string `json:"evaluation_result,omitempty"`
+
+	// RuleEvaluations is only populated by GetRuleSuite.
+	RuleEvaluations []*RuleEvaluation `json:"rule_evaluations,omitempty"`
+}
+
+func (r RuleSuite) String() string {
+	return Stringify(r)
+}
+
+// RuleEvaluation represents the evaluation of a single rule within a RuleSuite.
+type RuleEvaluation struct {
+	RuleSource *RuleSource `json:"rule_source,omitempty"`
+	// Enforcement is the enforcement level of the rule at the time of the push.
+	// Possible values are: active, evaluate, "deleted ruleset".
+	Enforcement *string `json:"enforcement,omitempty"`
+	// Result is the result of evaluating the rule. Possible values are: pass, fail.
+	Result   *string `json:"result,omitempty"`
+	RuleType *string `json:"rule_type,omitempty"`
+	// Details contains any associated details with the rule evaluation,
+	// such as the reason a rule failed.
+	Details *string `json:"details,omitempty"`
+}
+
+// RuleSource identifies the ruleset or protected branch a rule comes from.
+type RuleSource struct {
+	// Type is the type of rule source. Possible values are: ruleset, protected_branch.
+	Type *string `json:"type,omitempty"`
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// RuleSuiteListOptions specifies the optional parameters to the
+// RepositoriesService.ListRuleSuites method.
+type RuleSuiteListOptions struct {
+	// Ref is the name of the ref. Cannot contain wildcard characters.
+	// When specified, only rule evaluations triggered for this ref will be returned.
+	Ref string `url:"ref,omitempty"`
+
+	// TimePeriod is the time period to filter by. Possible values are:
+	// hour, day, week, month. Default is "day".
+	TimePeriod string `url:"time_period,omitempty"`
+
+	// ActorName is the handle for the GitHub user account to filter on.
+	ActorName string `url:"actor_name,omitempty"`
+
+	// RuleSuiteResult is the rule results to filter on. Possible values are:
+	// pass, fail, bypass, all. Default is "all".
+	RuleSuiteResult string `url:"rule_suite_result,omitempty"`
+
+	ListOptions
+}
+
+// ListRuleSuites lists suites of rule evaluations at the repository level.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/rule-suites#list-repository-rule-suites
+func (s *RepositoriesService) ListRuleSuites(ctx context.Context, owner, repo string, opts *RuleSuiteListOptions) ([]*RuleSuite, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/rule-suites", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var suites []*RuleSuite
+	resp, err := s.client.Do(ctx, req, &suites)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suites, resp, nil
+}
+
+// GetRuleSuite gets information about a suite of rule evaluations, including
+// the result of evaluating each rule and the ruleset it came from.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/rule-suites#get-a-repository-rule-suite
+func (s *RepositoriesService) GetRuleSuite(ctx context.Context, owner, repo string, ruleSuiteID int64) (*RuleSuite, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/rule-suites/%v", owner, repo, ruleSuiteID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suite := new(RuleSuite)
+	resp, err := s.client.Do(ctx, req, suite)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suite, resp, nil
+}
+
+// GetRuleSuiteForPush finds the suite of rule evaluations for the push whose
+// resulting head is afterSHA and returns it with its rule evaluations. This
+// explains why a given push was blocked or allowed.
+//
+// The rule suites matching opts are listed page by page until a suite for
+// afterSHA is found, so narrowing the search with opts.Ref and
+// opts.TimePeriod reduces the number of requests made. An error is returned
+// if no rule suite matches afterSHA.
+func (s *RepositoriesService) GetRuleSuiteForPush(ctx context.Context, owner, repo, afterSHA string, opts *RuleSuiteListOptions) (*RuleSuite, *Response, error) {
+	o := new(RuleSuiteListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	// errFound stops the pagination once the rule suite is found.
+	errFound := errors.New("rule suite found")
+
+	var id *int64
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		suites, resp, err := s.ListRuleSuites(ctx, owner, repo, o)
+		if err != nil {
+			return resp, err
+		}
+		for _, suite := range suites {
+			if suite.GetAfterSHA() == afterSHA {
+				id = suite.ID
+				return resp, errFound
+			}
+		}
+		return resp, nil
+	})
+	if err != nil && err != errFound {
+		return nil, resp, err
+	}
+	if id == nil {
+		return nil, resp, fmt.Errorf("no rule suite found for push %v in %v/%v", afterSHA, owner, repo)
+	}
+
+	return s.GetRuleSuite(ctx, owner, repo, *id)
+}
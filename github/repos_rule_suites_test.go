@@ -0,0 +1,166 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListRuleSuites(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ref":               "refs/heads/main",
+			"time_period":       "week",
+			"rule_suite_result": "fail",
+			"page":              "2",
+		})
+		fmt.Fprint(w, `[{"id":1, "after_sha":"a", "result":"fail"}]`)
+	})
+
+	opts := &RuleSuiteListOptions{
+		Ref:             "refs/heads/main",
+		TimePeriod:      "week",
+		RuleSuiteResult: "fail",
+		ListOptions:     ListOptions{Page: 2},
+	}
+	suites, _, err := client.Repositories.ListRuleSuites(context.Background(), "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListRuleSuites returned error: %v", err)
+	}
+
+	want := []*RuleSuite{{ID: Int64(1), AfterSHA: String("a"), Result: String("fail")}}
+	if !reflect.DeepEqual(suites, want) {
+		t.Errorf("Repositories.ListRuleSuites returned %+v, want %+v", suites, want)
+	}
+}
+
+func TestRepositoriesService_GetRuleSuite_failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 21,
+			"actor_id": 12,
+			"actor_name": "octocat",
+			"before_sha": "b",
+			"after_sha": "a",
+			"ref": "refs/heads/main",
+			"repository_id": 404,
+			"repository_name": "r",
+			"pushed_at": `+referenceTimeStr+`,
+			"result": "fail",
+			"evaluation_result": "fail",
+			"rule_evaluations": [
+				{
+					"rule_source": {"type": "ruleset", "id": 2, "name": "Require signed commits"},
+					"enforcement": "active",
+					"result": "fail",
+					"rule_type": "required_signatures",
+					"details": "Commits must have verified signatures."
+				},
+				{
+					"rule_source": {"type": "protected_branch"},
+					"enforcement": "evaluate",
+					"result": "pass",
+					"rule_type": "pull_request"
+				}
+			]
+		}`)
+	})
+
+	suite, _, err := client.Repositories.GetRuleSuite(context.Background(), "o", "r", 21)
+	if err != nil {
+		t.Errorf("Repositories.GetRuleSuite returned error: %v", err)
+	}
+
+	want := &RuleSuite{
+		ID:               Int64(21),
+		ActorID:          Int64(12),
+		ActorName:        String("octocat"),
+		BeforeSHA:        String("b"),
+		AfterSHA:         String("a"),
+		Ref:              String("refs/heads/main"),
+		RepositoryID:     Int64(404),
+		RepositoryName:   String("r"),
+		PushedAt:         &Timestamp{referenceTime},
+		Result:           String("fail"),
+		EvaluationResult: String("fail"),
+		RuleEvaluations: []*RuleEvaluation{
+			{
+				RuleSource:  &RuleSource{Type: String("ruleset"), ID: Int64(2), Name: String("Require signed commits")},
+				Enforcement: String("active"),
+				Result:      String("fail"),
+				RuleType:    String("required_signatures"),
+				Details:     String("Commits must have verified signatures."),
+			},
+			{
+				RuleSource:  &RuleSource{Type: String("protected_branch")},
+				Enforcement: String("evaluate"),
+				Result:      String("pass"),
+				RuleType:    String("pull_request"),
+			},
+		},
+	}
+	if !reflect.DeepEqual(suite, want) {
+		t.Errorf("Repositories.GetRuleSuite returned %+v, want %+v", suite, want)
+	}
+}
+
+func TestRepositoriesService_GetRuleSuiteForPush(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/rulesets/rule-suites?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1, "after_sha":"x"}]`)
+		case "2":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/rulesets/rule-suites?page=3>; rel="next"`)
+			fmt.Fprint(w, `[{"id":2, "after_sha":"a"}]`)
+		default:
+			t.Errorf("Repositories.GetRuleSuiteForPush kept paginating after finding the rule suite")
+		}
+	})
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2, "after_sha":"a", "result":"bypass"}`)
+	})
+
+	suite, _, err := client.Repositories.GetRuleSuiteForPush(context.Background(), "o", "r", "a", nil)
+	if err != nil {
+		t.Errorf("Repositories.GetRuleSuiteForPush returned error: %v", err)
+	}
+
+	want := &RuleSuite{ID: Int64(2), AfterSHA: String("a"), Result: String("bypass")}
+	if !reflect.DeepEqual(suite, want) {
+		t.Errorf("Repositories.GetRuleSuiteForPush returned %+v, want %+v", suite, want)
+	}
+}
+
+func TestRepositoriesService_GetRuleSuiteForPush_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/rule-suites", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1, "after_sha":"x"}]`)
+	})
+
+	if _, _, err := client.Repositories.GetRuleSuiteForPush(context.Background(), "o", "r", "a", nil); err == nil {
+		t.Error("Repositories.GetRuleSuiteForPush returned nil error, want error")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "encoding/json"
+
+// Ruleset represents a repository or organization ruleset.
+type Ruleset struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	// Target is the target of the ruleset. Possible values are: branch, tag,
+	// push.
+	Target *string `json:"target,omitempty"`
+	// SourceType is the type of the owner of the ruleset. Possible values
+	// are: Repository, Organization.
+	SourceType *string `json:"source_type,omitempty"`
+	// Source is the name of the owner of the ruleset.
+	Source *string `json:"source,omitempty"`
+	// Enforcement is the enforcement level of the ruleset. Possible values
+	// are: disabled, active, evaluate.
+	Enforcement  *string            `json:"enforcement,omitempty"`
+	BypassActors []*BypassActor     `json:"bypass_actors,omitempty"`
+	Conditions   *RulesetConditions `json:"conditions,omitempty"`
+	Rules        []*RepositoryRule  `json:"rules,omitempty"`
+	CreatedAt    *Timestamp         `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp         `json:"updated_at,omitempty"`
+}
+
+func (r Ruleset) String() string {
+	return Stringify(r)
+}
+
+// BypassActor represents an actor that can bypass the rules of a ruleset.
+type BypassActor struct {
+	ActorID *int64 `json:"actor_id,omitempty"`
+	// ActorType is the type of the actor. Possible values are: Integration,
+	// OrganizationAdmin, RepositoryRole, Team, DeployKey.
+	ActorType *string `json:"actor_type,omitempty"`
+	// BypassMode is when the actor can bypass the ruleset. Possible values
+	// are: always, pull_request.
+	BypassMode *string `json:"bypass_mode,omitempty"`
+}
+
+// RulesetConditions represents the conditions that decide which refs and
+// repositories a ruleset applies to.
+type RulesetConditions struct {
+	RefName        *RulesetRefConditionParameters             `json:"ref_name,omitempty"`
+	RepositoryName *RulesetRepositoryNamesConditionParameters `json:"repository_name,omitempty"`
+	RepositoryID   *RulesetRepositoryIDsConditionParameters   `json:"repository_id,omitempty"`
+}
+
+// RulesetRefConditionParameters represents the ref_name condition of a
+// ruleset. Patterns are fnmatch-style; ~DEFAULT_BRANCH and ~ALL are also
+// accepted.
+type RulesetRefConditionParameters struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// RulesetRepositoryNamesConditionParameters represents the repository_name
+// condition of an organization ruleset.
+type RulesetRepositoryNamesConditionParameters struct {
+	Include   []string `json:"include,omitempty"`
+	Exclude   []string `json:"exclude,omitempty"`
+	Protected *bool    `json:"protected,omitempty"`
+}
+
+// RulesetRepositoryIDsConditionParameters represents the repository_id
+// condition of an organization ruleset.
+type RulesetRepositoryIDsConditionParameters struct {
+	RepositoryIDs []int64 `json:"repository_ids,omitempty"`
+}
+
+// RepositoryRule represents a single rule of a ruleset.
+type RepositoryRule struct {
+	// Type is the type of the rule, for example creation, deletion,
+	// required_linear_history or pull_request.
+	Type *string `json:"type,omitempty"`
+	// Parameters holds the parameters of the rule, whose shape depends on
+	// Type. It is absent for rules without parameters.
+	Parameters *json.RawMessage `json:"parameters,omitempty"`
+}
@@ -151,6 +151,31 @@ func (r RepositoryParticipation) String() string {
 	return Stringify(r)
 }
 
+// TotalAll returns the number of commits by everyone over the last 52 weeks.
+func (r *RepositoryParticipation) TotalAll() int {
+	if r == nil {
+		return 0
+	}
+	return sumInts(r.All)
+}
+
+// TotalOwner returns the number of commits by the owner over the last 52
+// weeks.
+func (r *RepositoryParticipation) TotalOwner() int {
+	if r == nil {
+		return 0
+	}
+	return sumInts(r.Owner)
+}
+
+func sumInts(v []int) int {
+	var total int
+	for _, n := range v {
+		total += n
+	}
+	return total
+}
+
 // ListParticipation returns the total commit counts for the 'owner'
 // and total commit counts in 'all'. 'all' is everyone combined,
 // including the 'owner' in the last 52 weeks. If you’d like to get
@@ -181,6 +206,69 @@ func (s *RepositoriesService) ListParticipation(ctx context.Context, owner, repo
 	return participation, resp, nil
 }
 
+// StatsRetryOptions configures how GetWeeklyCommitCount waits for GitHub to
+// finish computing repository statistics.
+type StatsRetryOptions struct {
+	// Delay is the time waited between two attempts. Defaults to one second.
+	Delay time.Duration
+
+	// MaxAttempts is the maximum number of requests made, including the
+	// first one. Defaults to 5.
+	MaxAttempts int
+}
+
+// GetWeeklyCommitCount is like ListParticipation, but when GitHub responds
+// with 202 Accepted while it computes the statistics, the request is retried
+// after opts.Delay. If the statistics are still not ready after
+// opts.MaxAttempts attempts, the *AcceptedError from the last attempt is
+// returned. A nil opts uses the default StatsRetryOptions.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-the-weekly-commit-count
+func (s *RepositoriesService) GetWeeklyCommitCount(ctx context.Context, owner, repo string, opts *StatsRetryOptions) (*RepositoryParticipation, *Response, error) {
+	var participation *RepositoryParticipation
+	resp, err := retryAccepted(ctx, opts, func() (*Response, error) {
+		var resp *Response
+		var err error
+		participation, resp, err = s.ListParticipation(ctx, owner, repo)
+		return resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return participation, resp, nil
+}
+
+// retryAccepted calls fn until it returns something other than an
+// *AcceptedError, waiting opts.Delay between attempts, for at most
+// opts.MaxAttempts attempts.
+func retryAccepted(ctx context.Context, opts *StatsRetryOptions, fn func() (*Response, error)) (*Response, error) {
+	delay, maxAttempts := time.Second, 5
+	if opts != nil {
+		if opts.Delay > 0 {
+			delay = opts.Delay
+		}
+		if opts.MaxAttempts > 0 {
+			maxAttempts = opts.MaxAttempts
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := fn()
+		if _, ok := err.(*AcceptedError); !ok || attempt >= maxAttempts {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // PunchCard represents the number of commits made during a given hour of a
 // day of the week.
 type PunchCard struct {
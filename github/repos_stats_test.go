@@ -182,6 +182,73 @@ func TestRepositoriesService_Participation(t *testing.T) {
 	}
 }
 
+func TestRepositoryParticipation_Totals(t *testing.T) {
+	p := &RepositoryParticipation{
+		All:   []int{11, 21, 15, 2, 8},
+		Owner: []int{3, 2, 3, 0, 2},
+	}
+	if got, want := p.TotalAll(), 57; got != want {
+		t.Errorf("TotalAll returned %v, want %v", got, want)
+	}
+	if got, want := p.TotalOwner(), 10; got != want {
+		t.Errorf("TotalOwner returned %v, want %v", got, want)
+	}
+
+	var empty *RepositoryParticipation
+	if got := empty.TotalAll(); got != 0 {
+		t.Errorf("TotalAll on nil returned %v, want 0", got)
+	}
+}
+
+func TestRepositoriesService_GetWeeklyCommitCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/stats/participation", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `{"all":[11,21,15],"owner":[3,2,3]}`)
+	})
+
+	participation, _, err := client.Repositories.GetWeeklyCommitCount(context.Background(), "o", "r", &StatsRetryOptions{Delay: time.Millisecond})
+	if err != nil {
+		t.Fatalf("RepositoriesService.GetWeeklyCommitCount returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("RepositoriesService.GetWeeklyCommitCount made %v requests, want 3", calls)
+	}
+	if got, want := participation.TotalAll(), 47; got != want {
+		t.Errorf("TotalAll returned %v, want %v", got, want)
+	}
+	if got, want := participation.TotalOwner(), 8; got != want {
+		t.Errorf("TotalOwner returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_GetWeeklyCommitCount_stillComputing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/stats/participation", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, _, err := client.Repositories.GetWeeklyCommitCount(context.Background(), "o", "r", &StatsRetryOptions{Delay: time.Millisecond, MaxAttempts: 3})
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("RepositoriesService.GetWeeklyCommitCount returned error %v, want *AcceptedError", err)
+	}
+	if calls != 3 {
+		t.Errorf("RepositoriesService.GetWeeklyCommitCount made %v requests, want 3", calls)
+	}
+}
+
 func TestRepositoriesService_ListPunchCard(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -107,6 +107,10 @@ func (s CombinedStatus) String() string {
 // GetCombinedStatus returns the combined status of a repository at the specified
 // reference. ref can be a SHA, a branch name, or a tag name.
 //
+// The Statuses of the returned CombinedStatus are paginated according to opts,
+// while its TotalCount is the number of statuses across all pages. Use
+// GetCombinedStatusAll to fetch every status.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-the-combined-status-for-a-specific-reference
 func (s *RepositoriesService) GetCombinedStatus(ctx context.Context, owner, repo, ref string, opts *ListOptions) (*CombinedStatus, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/commits/%v/status", owner, repo, refURLEscape(ref))
@@ -128,3 +132,31 @@ func (s *RepositoriesService) GetCombinedStatus(ctx context.Context, owner, repo
 
 	return status, resp, nil
 }
+
+// GetCombinedStatusAll returns the combined status of a repository at the
+// specified reference, like GetCombinedStatus, with the statuses of every
+// context collected by following pagination. opts.Page is used as the first
+// page to fetch.
+//
+// If a request fails, the combined status fetched so far is returned along
+// with the error.
+func (s *RepositoriesService) GetCombinedStatusAll(ctx context.Context, owner, repo, ref string, opts *ListOptions) (*CombinedStatus, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var combined *CombinedStatus
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		status, resp, err := s.GetCombinedStatus(ctx, owner, repo, ref, o)
+		if status != nil {
+			if combined == nil {
+				combined = status
+			} else {
+				combined.Statuses = append(combined.Statuses, status.Statuses...)
+			}
+		}
+		return resp, err
+	})
+	return combined, resp, err
+}
@@ -101,3 +101,41 @@ func TestRepositoriesService_GetCombinedStatus(t *testing.T) {
 		t.Errorf("Repositories.GetCombinedStatus returned %+v, want %+v", status, want)
 	}
 }
+
+func TestRepositoriesService_GetCombinedStatusAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/r/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			testFormValues(t, r, values{"per_page": "1"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/r/status?per_page=1&page=2>; rel="next"`)
+			fmt.Fprint(w, `{"state":"pending","total_count":2,"statuses":[{"id":1,"context":"ci/build"}]}`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "1", "page": "2"})
+			fmt.Fprint(w, `{"state":"pending","total_count":2,"statuses":[{"id":2,"context":"ci/lint"}]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &ListOptions{PerPage: 1}
+	status, _, err := client.Repositories.GetCombinedStatusAll(context.Background(), "o", "r", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.GetCombinedStatusAll returned error: %v", err)
+	}
+
+	want := &CombinedStatus{
+		State:      String("pending"),
+		TotalCount: Int(2),
+		Statuses: []*RepoStatus{
+			{ID: Int64(1), Context: String("ci/build")},
+			{ID: Int64(2), Context: String("ci/lint")},
+		},
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Repositories.GetCombinedStatusAll returned %+v, want %+v", status, want)
+	}
+}
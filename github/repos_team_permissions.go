@@ -0,0 +1,163 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+)
+
+// TeamEffectivePermission is a team with access to a repository, along with
+// the permission its members effectively have on that repository.
+type TeamEffectivePermission struct {
+	Team *Team
+
+	// Permission is the permission granted to the team itself on the
+	// repository, as returned by RepositoriesService.ListTeams.
+	Permission string
+
+	// EffectivePermission is the highest of Permission, the permissions
+	// granted on the repository to the team's ancestors, and the base
+	// permission of the organization if BasePermissionKnown is set.
+	// Possible values are: pull, triage, push, maintain, admin, or a custom
+	// repository role name.
+	EffectivePermission string
+
+	// BasePermissionKnown reports whether the base permission of the
+	// organization was taken into account. GitHub only returns it to the
+	// owners of the organization, so it is false for anyone else, and
+	// EffectivePermission may then be lower than the actual one.
+	BasePermissionKnown bool
+
+	// Source is the slug of the team EffectivePermission was granted to,
+	// which is the team itself or one of its ancestors. It is empty if
+	// EffectivePermission is the base permission of the organization.
+	Source string
+}
+
+// normalizeRepoPermission maps organization base permissions to the
+// equivalent team permission.
+func normalizeRepoPermission(permission string) string {
+	switch permission {
+	case "read":
+		return "pull"
+	case "write":
+		return "push"
+	case "none":
+		return ""
+	}
+	return permission
+}
+
+// ListRepositoryTeamsWithEffectivePermission lists the teams with access to
+// a repository owned by an organization, along with the permission each of
+// them effectively has on it. Unlike RepositoriesService.ListTeams, which
+// reports the permission granted to each team, this takes into account the
+// permissions inherited from parent teams and the organization's base
+// permission.
+//
+// This is expensive: on top of listing all the teams of the repository and
+// getting the organization, it makes two requests for each ancestor team that
+// is not itself listed, to get its own parent and its permission on the
+// repository. Ancestors are looked up once per call, however deep or shared.
+// The base permission of the organization is only visible to its owners;
+// when it is not, TeamEffectivePermission.BasePermissionKnown is false and
+// the effective permissions leave it out.
+//
+// If the pages of teams are capped with Client.WithMaxPages, the effective
+// permissions of the teams listed are returned along with
+// ErrMaxPagesExceeded.
+func (s *RepositoriesService) ListRepositoryTeamsWithEffectivePermission(ctx context.Context, org, repo string) ([]*TeamEffectivePermission, *Response, error) {
+	var teams []*Team
+	opts := &ListOptions{}
+	resp, err := paginate(s.client.maxPages, opts, func() (*Response, error) {
+		page, resp, err := s.ListTeams(ctx, org, repo, opts)
+		teams = append(teams, page...)
+		return resp, err
+	})
+	if err != nil && err != ErrMaxPagesExceeded {
+		return nil, resp, err
+	}
+	pageErr := err
+
+	o, resp, err := s.client.Organizations.Get(ctx, org)
+	if err != nil {
+		return nil, resp, err
+	}
+	// default_repository_permission is only returned to organization owners.
+	baseKnown := o.DefaultRepoPermission != nil
+	base := normalizeRepoPermission(o.GetDefaultRepoPermission())
+
+	// Teams listed for the repository are complete, apart from the parent of
+	// their parent; ancestors are filled in as they are looked up.
+	granted := make(map[string]string)
+	parents := make(map[string]*Team)
+	for _, t := range teams {
+		granted[t.GetSlug()] = t.GetPermission()
+		parents[t.GetSlug()] = t.Parent
+	}
+
+	var result []*TeamEffectivePermission
+	for _, t := range teams {
+		p := &TeamEffectivePermission{
+			Team:                t,
+			Permission:          t.GetPermission(),
+			EffectivePermission: t.GetPermission(),
+			Source:              t.GetSlug(),
+			BasePermissionKnown: baseKnown,
+		}
+
+		seen := map[string]bool{t.GetSlug(): true}
+		for parent := parents[t.GetSlug()]; parent != nil && !seen[parent.GetSlug()]; parent = parents[parent.GetSlug()] {
+			slug := parent.GetSlug()
+			seen[slug] = true
+			if _, ok := parents[slug]; !ok {
+				if resp, err := s.lookUpAncestorTeam(ctx, org, repo, slug, granted, parents); err != nil {
+					return nil, resp, err
+				}
+			}
+			if ParsePermission(granted[slug]) > ParsePermission(p.EffectivePermission) {
+				p.EffectivePermission = granted[slug]
+				p.Source = slug
+			}
+		}
+
+		if ParsePermission(base) > ParsePermission(p.EffectivePermission) {
+			p.EffectivePermission = base
+			p.Source = ""
+		}
+		result = append(result, p)
+	}
+
+	return result, resp, pageErr
+}
+
+// lookUpAncestorTeam records the parent of the team identified by slug in
+// parents, and its permission on the repository in granted.
+func (s *RepositoriesService) lookUpAncestorTeam(ctx context.Context, org, repo, slug string, granted map[string]string, parents map[string]*Team) (*Response, error) {
+	team, resp, err := s.client.Teams.GetTeamBySlug(ctx, org, slug)
+	if err != nil {
+		return resp, err
+	}
+	parents[slug] = team.Parent
+
+	r, resp, err := s.client.Teams.IsTeamRepoBySlug(ctx, org, slug, org, repo)
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusNotFound {
+		return resp, nil // The team has no access to the repository.
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	var permission string
+	for p, ok := range r.GetPermissions() {
+		if ok && ParsePermission(p) > ParsePermission(permission) {
+			permission = p
+		}
+	}
+	granted[slug] = permission
+	return resp, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListRepositoryTeamsWithEffectivePermission(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The hierarchy is top > mid > child > leaf, plus the unrelated teams
+	// other and solo. Only top, among the ancestors not listed for the
+	// repository, has access to it.
+	mux.HandleFunc("/repos/o/r/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/teams?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"slug":"child","permission":"pull","parent":{"slug":"mid"}},{"slug":"leaf","permission":"triage","parent":{"slug":"child"}}]`)
+		case "2":
+			fmt.Fprint(w, `[{"slug":"other","permission":"admin"},{"slug":"solo","permission":"pull"}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"login":"o","default_repository_permission":"read"}`)
+	})
+
+	lookups := map[string]int{}
+	mux.HandleFunc("/orgs/o/teams/mid", func(w http.ResponseWriter, r *http.Request) {
+		lookups["mid"]++
+		fmt.Fprint(w, `{"slug":"mid","parent":{"slug":"top"}}`)
+	})
+	mux.HandleFunc("/orgs/o/teams/mid/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/orgs/o/teams/top", func(w http.ResponseWriter, r *http.Request) {
+		lookups["top"]++
+		fmt.Fprint(w, `{"slug":"top"}`)
+	})
+	mux.HandleFunc("/orgs/o/teams/top/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"r","permissions":{"admin":false,"maintain":false,"push":true,"triage":true,"pull":true}}`)
+	})
+
+	got, _, err := client.Repositories.ListRepositoryTeamsWithEffectivePermission(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ListRepositoryTeamsWithEffectivePermission returned error: %v", err)
+	}
+
+	type result struct{ Slug, Permission, Effective, Source string }
+	var results []result
+	for _, p := range got {
+		results = append(results, result{p.Team.GetSlug(), p.Permission, p.EffectivePermission, p.Source})
+	}
+	want := []result{
+		{"child", "pull", "push", "top"},
+		{"leaf", "triage", "push", "top"},
+		{"other", "admin", "admin", "other"},
+		{"solo", "pull", "pull", "solo"},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("Repositories.ListRepositoryTeamsWithEffectivePermission returned %+v, want %+v", results, want)
+	}
+	if want := map[string]int{"mid": 1, "top": 1}; !reflect.DeepEqual(lookups, want) {
+		t.Errorf("ancestor teams were looked up %v times, want %v", lookups, want)
+	}
+}
+
+func TestRepositoriesService_ListRepositoryTeamsWithEffectivePermission_basePermission(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/teams", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"slug":"t","permission":"pull"}]`)
+	})
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"login":"o","default_repository_permission":"write"}`)
+	})
+
+	got, _, err := client.Repositories.ListRepositoryTeamsWithEffectivePermission(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ListRepositoryTeamsWithEffectivePermission returned error: %v", err)
+	}
+
+	want := []*TeamEffectivePermission{{
+		Team:                &Team{Slug: String("t"), Permission: String("pull")},
+		Permission:          "pull",
+		EffectivePermission: "push",
+		BasePermissionKnown: true,
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListRepositoryTeamsWithEffectivePermission returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListRepositoryTeamsWithEffectivePermission_notOwner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/teams", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"slug":"t","permission":"pull"}]`)
+	})
+	mux.HandleFunc("/orgs/o", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"login":"o"}`)
+	})
+
+	got, _, err := client.Repositories.ListRepositoryTeamsWithEffectivePermission(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ListRepositoryTeamsWithEffectivePermission returned error: %v", err)
+	}
+
+	want := []*TeamEffectivePermission{{
+		Team:                &Team{Slug: String("t"), Permission: String("pull")},
+		Permission:          "pull",
+		EffectivePermission: "pull",
+		Source:              "t",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListRepositoryTeamsWithEffectivePermission returned %+v, want %+v", got, want)
+	}
+}
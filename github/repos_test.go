@@ -242,6 +242,40 @@ func TestRepositoriesService_ListAll(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListAllFunc(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch since := r.FormValue("since"); since {
+		case "":
+			fmt.Fprint(w, `[{"id":1},{"id":3}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id":4}]`)
+		case "4":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected since %q", since)
+		}
+	})
+
+	var got []int64
+	_, err := client.Repositories.ListAllFunc(context.Background(), nil, func(repos []*Repository) error {
+		for _, r := range repos {
+			got = append(got, r.GetID())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.ListAllFunc returned error: %v", err)
+	}
+
+	if want := []int64{1, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListAllFunc listed repository IDs %v, want %v", got, want)
+	}
+}
+
 func TestRepositoriesService_Create_user(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -438,6 +472,78 @@ func TestRepositoriesService_Get(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_EstimateCloneSize(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"size":2048}`)
+	})
+
+	size, _, err := client.Repositories.EstimateCloneSize(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.EstimateCloneSize returned error: %v", err)
+	}
+	if want := int64(2048 * 1024); size != want {
+		t.Errorf("Repositories.EstimateCloneSize returned %v, want %v", size, want)
+	}
+}
+
+func TestRepository_decodeSize(t *testing.T) {
+	var repo Repository
+	if err := json.Unmarshal([]byte(`{"size":108}`), &repo); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if got, want := repo.GetSize(), 108; got != want {
+		t.Errorf("Repository.Size = %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_GetRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"full_name":"o/a"}`)
+	})
+	mux.HandleFunc("/repos/o/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":2,"full_name":"o/b"}`)
+	})
+	mux.HandleFunc("/repos/o/gone", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/private", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+	})
+	mux.HandleFunc("/repos/o/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	names := []string{"o/gone", "o/a", "o/private", "o/b", "bogus", "o/missing"}
+	repos, notFound, err := client.Repositories.GetRepositories(context.Background(), names)
+
+	wantRepos := map[string]*Repository{
+		"o/a": {ID: Int64(1), FullName: String("o/a")},
+		"o/b": {ID: Int64(2), FullName: String("o/b")},
+	}
+	if !reflect.DeepEqual(repos, wantRepos) {
+		t.Errorf("Repositories.GetRepositories returned %+v, want %+v", repos, wantRepos)
+	}
+	if want := []string{"o/gone", "o/missing"}; !reflect.DeepEqual(notFound, want) {
+		t.Errorf("Repositories.GetRepositories returned notFound %v, want %v", notFound, want)
+	}
+
+	be, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.GetRepositories returned error %#v, want *BatchError", err)
+	}
+	if len(be.Errors) != 2 || be.Errors["o/private"] == nil || be.Errors["bogus"] == nil {
+		t.Errorf("BatchError.Errors = %v, want errors for o/private and bogus", be.Errors)
+	}
+}
+
 func TestRepositoriesService_GetCodeOfConduct(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -817,6 +923,34 @@ func TestRepositoriesService_ListTags(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListTagsAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/tags?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"v1.0.0"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"name":"v1.1.0"}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	tags, _, err := client.Repositories.ListTagsAll(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Repositories.ListTagsAll returned error: %v", err)
+	}
+
+	want := []*RepositoryTag{{Name: String("v1.0.0")}, {Name: String("v1.1.0")}}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("Repositories.ListTagsAll returned %+v, want %+v", tags, want)
+	}
+}
+
 func TestRepositoriesService_ListBranches(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -844,6 +978,52 @@ func TestRepositoriesService_ListBranches(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListBranchesAll_protected(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			testFormValues(t, r, values{"protected": "true"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/branches?protected=true&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"main","commit":{"sha":"a57781"},"protected":true,"protection":{"enabled":true,"required_status_checks":{"enforcement_level":"everyone","contexts":["ci"]}},"protection_url":"https://api.github.com/repos/o/r/branches/main/protection"}]`)
+		case "2":
+			testFormValues(t, r, values{"protected": "true", "page": "2"})
+			fmt.Fprint(w, `[{"name":"release","commit":{"sha":"b12345"},"protected":true}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &BranchListOptions{Protected: Bool(true)}
+	branches, _, err := client.Repositories.ListBranchesAll(context.Background(), "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListBranchesAll returned error: %v", err)
+	}
+
+	want := []*Branch{
+		{
+			Name:      String("main"),
+			Commit:    &RepositoryCommit{SHA: String("a57781")},
+			Protected: Bool(true),
+			Protection: &BranchProtectionSummary{
+				Enabled: Bool(true),
+				RequiredStatusChecks: &BranchStatusChecksSummary{
+					EnforcementLevel: String("everyone"),
+					Contexts:         []string{"ci"},
+				},
+			},
+			ProtectionURL: String("https://api.github.com/repos/o/r/branches/main/protection"),
+		},
+		{Name: String("release"), Commit: &RepositoryCommit{SHA: String("b12345")}, Protected: Bool(true)},
+	}
+	if !reflect.DeepEqual(branches, want) {
+		t.Errorf("Repositories.ListBranchesAll returned %+v, want %+v", branches, want)
+	}
+}
+
 func TestRepositoriesService_GetBranch(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -876,6 +1056,74 @@ func TestRepositoriesService_GetBranch(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetDefaultBranch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"main","commit":{"sha":"s"}}`)
+	})
+
+	branch, _, err := client.Repositories.GetDefaultBranch(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetDefaultBranch returned error: %v", err)
+	}
+
+	want := &Branch{Name: String("main"), Commit: &RepositoryCommit{SHA: String("s")}}
+	if !reflect.DeepEqual(branch, want) {
+		t.Errorf("Repositories.GetDefaultBranch returned %+v, want %+v", branch, want)
+	}
+}
+
+func TestRepositoriesService_GetDefaultBranch_emptyRepository(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Branch not found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "1"})
+		http.Error(w, `{"message":"Git Repository is empty."}`, http.StatusConflict)
+	})
+
+	branch, _, err := client.Repositories.GetDefaultBranch(context.Background(), "o", "r")
+	if err != ErrEmptyRepository {
+		t.Errorf("Repositories.GetDefaultBranch returned error %v, want ErrEmptyRepository", err)
+	}
+	if branch != nil {
+		t.Errorf("Repositories.GetDefaultBranch returned %+v, want nil", branch)
+	}
+}
+
+func TestRepositoriesService_GetDefaultBranch_branchNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Branch not found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/commits", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"sha":"s"}]`)
+	})
+
+	_, _, err := client.Repositories.GetDefaultBranch(context.Background(), "o", "r")
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Repositories.GetDefaultBranch returned error %v, want a 404 *ErrorResponse", err)
+	}
+}
+
 func TestRepositoriesService_GetBranchProtection(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1123,6 +1371,63 @@ func TestRepositoriesService_UpdateBranchProtection(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ApplyDefaultBranchProtection(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	template := &ProtectionRequest{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict:   true,
+			Contexts: []string{"ci"},
+		},
+		EnforceAdmins: true,
+	}
+
+	for repo, branch := range map[string]string{"a": "main", "b": "master", "c": "trunk"} {
+		branch := branch
+		mux.HandleFunc("/repos/o/"+repo, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"default_branch":%q}`, branch)
+		})
+	}
+	for _, path := range []string{"/repos/o/a/branches/main/protection", "/repos/o/b/branches/master/protection"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "PUT")
+			v := new(ProtectionRequest)
+			json.NewDecoder(r.Body).Decode(v)
+			if !reflect.DeepEqual(v, template) {
+				t.Errorf("Request body = %+v, want %+v", v, template)
+			}
+			fmt.Fprint(w, `{"enforce_admins":{"enabled":true}}`)
+		})
+	}
+	mux.HandleFunc("/repos/o/c/branches/trunk/protection", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Upgrade to GitHub Pro"}`, http.StatusForbidden)
+	})
+	mux.HandleFunc("/repos/o/gone", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	names := []string{"o/a", "o/b", "o/c", "o/gone"}
+	got, err := client.Repositories.ApplyDefaultBranchProtection(context.Background(), names, template)
+
+	want := map[string]*Protection{
+		"o/a": {EnforceAdmins: &AdminEnforcement{Enabled: true}},
+		"o/b": {EnforceAdmins: &AdminEnforcement{Enabled: true}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ApplyDefaultBranchProtection returned %+v, want %+v", got, want)
+	}
+
+	be, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("Repositories.ApplyDefaultBranchProtection returned error %#v, want *BatchError", err)
+	}
+	if len(be.Errors) != 2 || be.Errors["o/c"] == nil || be.Errors["o/gone"] == nil {
+		t.Errorf("BatchError.Errors = %v, want errors for o/c and o/gone", be.Errors)
+	}
+}
+
 func TestRepositoriesService_RemoveBranchProtection(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1267,6 +1572,75 @@ func TestRepositoriesService_ListRequiredStatusChecksContexts(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_UpdateRequiredStatusChecks_checks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection/required_status_checks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"checks":[{"context":"ci","app_id":1},{"context":"lint"}]}`+"\n")
+		fmt.Fprint(w, `{"strict":false,"contexts":["ci","lint"],"checks":[{"context":"ci","app_id":1},{"context":"lint","app_id":null}]}`)
+	})
+
+	input := &RequiredStatusChecksRequest{
+		Checks: []*RequiredStatusCheck{{Context: "ci", AppID: Int64(1)}, {Context: "lint"}},
+	}
+	statusChecks, _, err := client.Repositories.UpdateRequiredStatusChecks(context.Background(), "o", "r", "b", input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateRequiredStatusChecks returned error: %v", err)
+	}
+
+	want := &RequiredStatusChecks{
+		Contexts: []string{"ci", "lint"},
+		Checks:   []*RequiredStatusCheck{{Context: "ci", AppID: Int64(1)}, {Context: "lint"}},
+	}
+	if !reflect.DeepEqual(statusChecks, want) {
+		t.Errorf("Repositories.UpdateRequiredStatusChecks returned %+v, want %+v", statusChecks, want)
+	}
+}
+
+func TestRepositoriesService_AddRequiredStatusChecksContexts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection/required_status_checks/contexts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"contexts":["y"]}`+"\n")
+		fmt.Fprint(w, `["x", "y"]`)
+	})
+
+	contexts, _, err := client.Repositories.AddRequiredStatusChecksContexts(context.Background(), "o", "r", "b", []string{"y"})
+	if err != nil {
+		t.Errorf("Repositories.AddRequiredStatusChecksContexts returned error: %v", err)
+	}
+
+	want := []string{"x", "y"}
+	if !reflect.DeepEqual(contexts, want) {
+		t.Errorf("Repositories.AddRequiredStatusChecksContexts returned %+v, want %+v", contexts, want)
+	}
+}
+
+func TestRepositoriesService_RemoveRequiredStatusChecksContexts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/protection/required_status_checks/contexts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"contexts":["y"]}`+"\n")
+		fmt.Fprint(w, `["x"]`)
+	})
+
+	contexts, _, err := client.Repositories.RemoveRequiredStatusChecksContexts(context.Background(), "o", "r", "b", []string{"y"})
+	if err != nil {
+		t.Errorf("Repositories.RemoveRequiredStatusChecksContexts returned error: %v", err)
+	}
+
+	want := []string{"x"}
+	if !reflect.DeepEqual(contexts, want) {
+		t.Errorf("Repositories.RemoveRequiredStatusChecksContexts returned %+v, want %+v", contexts, want)
+	}
+}
+
 func TestRepositoriesService_GetPullRequestReviewEnforcement(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
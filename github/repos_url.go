@@ -0,0 +1,69 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ParseRepositoryURL returns the owner and name of the repository that raw
+// refers to. raw may be any of the URLs GitHub uses for a repository, on
+// GitHub.com or a GitHub Enterprise Server host:
+//
+//	https://github.com/owner/repo (with or without a trailing .git)
+//	https://github.com/owner/repo/tree/main/dir (or any other subpath)
+//	git@github.com:owner/repo.git
+//	ssh://git@github.com/owner/repo.git
+//	git://github.com/owner/repo.git
+//	https://api.github.com/repos/owner/repo
+//	https://github.example.com/api/v3/repos/owner/repo
+func ParseRepositoryURL(raw string) (owner, repo string, err error) {
+	s := strings.TrimSpace(raw)
+
+	var host, path string
+	if i := strings.Index(s, ":"); i > 0 && !strings.Contains(s, "://") && !strings.Contains(s[:i], "/") {
+		// An scp-like address, such as git@github.com:owner/repo.git.
+		host, path = s[:i], s[i+1:]
+		if j := strings.LastIndex(host, "@"); j >= 0 {
+			host = host[j+1:]
+		}
+	} else {
+		u, err := url.Parse(s)
+		if err != nil {
+			return "", "", fmt.Errorf("parsing repository URL %q: %w", raw, err)
+		}
+		host, path = u.Hostname(), u.Path
+	}
+	if host == "" {
+		return "", "", fmt.Errorf("%q is not a repository URL: missing host", raw)
+	}
+
+	var segments []string
+	for _, seg := range strings.Split(path, "/") {
+		if seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+
+	// Strip the prefix of REST API URLs.
+	switch {
+	case strings.HasPrefix(host, "api.") && len(segments) > 0 && segments[0] == "repos":
+		segments = segments[1:]
+	case len(segments) > 2 && segments[0] == "api" && segments[1] == "v3" && segments[2] == "repos":
+		segments = segments[3:]
+	}
+
+	if len(segments) < 2 {
+		return "", "", fmt.Errorf("%q is not a repository URL: missing owner or repository name", raw)
+	}
+	owner, repo = segments[0], strings.TrimSuffix(segments[1], ".git")
+	if repo == "" {
+		return "", "", fmt.Errorf("%q is not a repository URL: missing repository name", raw)
+	}
+	return owner, repo, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "testing"
+
+func TestParseRepositoryURL(t *testing.T) {
+	tests := []struct {
+		raw string
+	}{
+		{"https://github.com/o/r"},
+		{"https://github.com/o/r/"},
+		{"https://github.com/o/r.git"},
+		{"http://github.com/o/r"},
+		{"https://github.com/o/r/tree/main/docs"},
+		{"https://github.com/o/r/pull/1"},
+		{"https://github.com/o/r/blob/main/README.md#usage"},
+		{"https://github.com/o/r?tab=readme"},
+		{"  https://github.com/o/r\n"},
+		{"git@github.com:o/r.git"},
+		{"git@github.com:o/r"},
+		{"github.com:o/r.git"},
+		{"ssh://git@github.com/o/r.git"},
+		{"ssh://git@github.com:22/o/r.git"},
+		{"git://github.com/o/r.git"},
+		{"https://api.github.com/repos/o/r"},
+		{"https://api.github.com/repos/o/r/pulls/1"},
+		{"https://github.example.com/o/r"},
+		{"https://github.example.com/o/r.git"},
+		{"git@github.example.com:o/r.git"},
+		{"https://github.example.com/api/v3/repos/o/r"},
+		{"https://github.example.com/api/v3/repos/o/r/issues/1"},
+	}
+
+	for _, tt := range tests {
+		owner, repo, err := ParseRepositoryURL(tt.raw)
+		if err != nil {
+			t.Errorf("ParseRepositoryURL(%q) returned error: %v", tt.raw, err)
+			continue
+		}
+		if owner != "o" || repo != "r" {
+			t.Errorf("ParseRepositoryURL(%q) = %q, %q, want %q, %q", tt.raw, owner, repo, "o", "r")
+		}
+	}
+}
+
+func TestParseRepositoryURL_invalid(t *testing.T) {
+	for _, raw := range []string{
+		"",
+		"o/r",
+		"https://github.com",
+		"https://github.com/o",
+		"https://github.com/o/.git",
+		"https://api.github.com/repos/o",
+		"git@github.com:o",
+		"https://github.com/%zz/r",
+	} {
+		if owner, repo, err := ParseRepositoryURL(raw); err == nil {
+			t.Errorf("ParseRepositoryURL(%q) = %q, %q, want error", raw, owner, repo)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// HeaderIdempotencyKey is the request header that marks a POST or PATCH
+// request as safe to retry. See Client.WithRetry.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+// RetryOptions configures how a client created with Client.WithRetry retries
+// failed requests.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of times a request is retried after
+	// its first attempt. Defaults to 3.
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry. Each further retry
+	// doubles the delay, up to MaxBackoff. A random jitter of up to half the
+	// delay is subtracted from every delay. Defaults to one second.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between two attempts. Defaults to 30
+	// seconds.
+	MaxBackoff time.Duration
+}
+
+// WithRetry returns a copy of c (see Clone) that retries requests failing
+// with a transient server error (a 5xx status other than 501 Not Implemented)
+// or a network error, with exponential backoff and jitter. A nil opts uses
+// the default RetryOptions.
+//
+// Requests rejected by the primary or a secondary rate limit are retried
+// after the delay given by Response.SuggestedRetryDelay, provided it does not
+// exceed opts.MaxBackoff; otherwise the rate limit error is returned right
+// away.
+//
+// Only idempotent requests are retried: GET, HEAD, OPTIONS, PUT and DELETE
+// requests, and POST or PATCH requests carrying a HeaderIdempotencyKey
+// header. Requests whose body cannot be replayed, such as uploads from an
+// arbitrary io.Reader, are never retried. Retrying stops as soon as the
+// request's context is done.
+func (c *Client) WithRetry(opts *RetryOptions) *Client {
+	o := RetryOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 3
+	}
+	if o.MinBackoff == 0 {
+		o.MinBackoff = time.Second
+	}
+	if o.MaxBackoff == 0 {
+		o.MaxBackoff = 30 * time.Second
+	}
+
+	c2 := c.Clone()
+	c2.client.Transport = &retryTransport{
+		opts:      o,
+		Transport: c2.client.Transport,
+	}
+	return c2
+}
+
+// retryTransport is an http.RoundTripper that retries idempotent requests
+// failing with transient errors. It is used by Client.WithRetry.
+type retryTransport struct {
+	opts RetryOptions
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !retryableRequest(req) {
+		return t.transport().RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.transport().RoundTrip(r)
+		if attempt >= t.opts.MaxRetries || ctx.Err() != nil {
+			return resp, err
+		}
+		delay, retry := t.retryDelay(resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay reports whether a request that returned resp and err on the
+// given attempt should be retried, and after how long. Requests rejected by a
+// rate limit are retried after the delay suggested by the response, if it
+// does not exceed MaxBackoff; transient failures are retried with
+// exponential backoff.
+func (t *retryTransport) retryDelay(resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err == nil {
+		if d, ok := (&Response{Response: resp}).SuggestedRetryDelay(); ok && d <= t.opts.MaxBackoff {
+			return d, true
+		}
+	}
+	if !transientFailure(resp, err) {
+		return 0, false
+	}
+	return t.backoff(attempt), true
+}
+
+// backoff returns the delay before retrying after the given attempt.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	d := t.opts.MinBackoff
+	for i := 0; i < attempt && d < t.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > t.opts.MaxBackoff {
+		d = t.opts.MaxBackoff
+	}
+	if jitter := int64(d / 2); jitter > 0 {
+		d -= time.Duration(rand.Int63n(jitter + 1))
+	}
+	return d
+}
+
+func (t *retryTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// retryableRequest reports whether req is idempotent and can be sent again.
+func retryableRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	case "POST", "PATCH":
+		return req.Header.Get(HeaderIdempotencyKey) != ""
+	}
+	return false
+}
+
+// transientFailure reports whether a request that returned resp and err
+// failed in a way that may succeed when retried.
+func transientFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_WithRetry_serverError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	client = client.WithRetry(&RetryOptions{MinBackoff: time.Millisecond})
+	repo, _, err := client.Repositories.Get(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if got, want := repo.GetID(), int64(1); got != want {
+		t.Errorf("Repositories.Get returned ID %v, want %v", got, want)
+	}
+	if got, want := calls, 2; got != want {
+		t.Errorf("server was called %v times, want %v", got, want)
+	}
+}
+
+func TestClient_WithRetry_maxRetries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	client = client.WithRetry(&RetryOptions{MaxRetries: 2, MinBackoff: time.Millisecond})
+	_, resp, err := client.Repositories.Get(context.Background(), "o", "r")
+	if err == nil {
+		t.Fatal("Repositories.Get returned no error")
+	}
+	if got, want := resp.StatusCode, http.StatusBadGateway; got != want {
+		t.Errorf("Repositories.Get returned status %v, want %v", got, want)
+	}
+	if got, want := calls, 3; got != want {
+		t.Errorf("server was called %v times, want %v", got, want)
+	}
+}
+
+func TestClient_WithRetry_post(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, strings.TrimSpace(string(b)))
+		if r.Header.Get(HeaderIdempotencyKey) == "" || len(bodies) > 1 {
+			fmt.Fprint(w, `{"number":1}`)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	client = client.WithRetry(&RetryOptions{MinBackoff: time.Millisecond})
+	ctx := context.Background()
+
+	req, err := client.NewRequest("POST", "repos/o/r/issues", &IssueRequest{Title: String("t")})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	req.Header.Set(HeaderIdempotencyKey, "k")
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	want := []string{`{"title":"t"}`, `{"title":"t"}`}
+	if fmt.Sprint(bodies) != fmt.Sprint(want) {
+		t.Errorf("server received bodies %v, want %v", bodies, want)
+	}
+}
+
+func TestClient_WithRetry_postWithoutIdempotencyKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client = client.WithRetry(&RetryOptions{MinBackoff: time.Millisecond})
+	if _, _, err := client.Issues.Create(context.Background(), "o", "r", &IssueRequest{Title: String("t")}); err == nil {
+		t.Error("Issues.Create returned no error")
+	}
+	if got, want := calls, 1; got != want {
+		t.Errorf("server was called %v times, want %v", got, want)
+	}
+}
+
+func TestClient_WithRetry_contextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client = client.WithRetry(&RetryOptions{MinBackoff: time.Hour, MaxBackoff: time.Hour})
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err == nil {
+		t.Error("Repositories.Get returned no error")
+	}
+	if got, want := calls, 1; got != want {
+		t.Errorf("server was called %v times, want %v", got, want)
+	}
+}
+
+func TestClient_WithRetry_rateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	client = client.WithRetry(&RetryOptions{MinBackoff: time.Hour, MaxBackoff: time.Hour})
+	_, _, err := client.Repositories.Get(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if got, want := calls, 2; got != want {
+		t.Errorf("server was called %v times, want %v", got, want)
+	}
+}
+
+func TestClient_WithRetry_rateLimitBeyondMaxBackoff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+	})
+
+	client = client.WithRetry(&RetryOptions{MinBackoff: time.Millisecond})
+	_, _, err := client.Repositories.Get(context.Background(), "o", "r")
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Fatalf("Repositories.Get returned error %v, want *RateLimitError", err)
+	}
+	if got, want := calls, 1; got != want {
+		t.Errorf("server was called %v times, want %v", got, want)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	tr := &retryTransport{opts: RetryOptions{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 500 * time.Millisecond, time.Second},
+		{1, time.Second, 2 * time.Second},
+		{2, 2 * time.Second, 4 * time.Second},
+		{3, 2500 * time.Millisecond, 5 * time.Second},
+		{10, 2500 * time.Millisecond, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if d := tr.backoff(tt.attempt); d < tt.min || d > tt.max {
+			t.Errorf("backoff(%v) = %v, want between %v and %v", tt.attempt, d, tt.min, tt.max)
+		}
+	}
+}
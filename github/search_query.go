@@ -0,0 +1,105 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"strings"
+	"time"
+)
+
+// SearchQuery builds a query string for the SearchService methods, quoting
+// values that contain spaces or other characters with special meaning in
+// search syntax. The zero value is an empty query ready to use. Methods
+// return the query so that calls can be chained:
+//
+//	q := new(github.SearchQuery).Keyword("memory leak").Repo("golang", "go").Label("help wanted")
+//	cl.Search.Issues(ctx, q.String(), nil)
+//
+// produces the query `"memory leak" repo:golang/go label:"help wanted"`.
+type SearchQuery struct {
+	terms []string
+}
+
+// Keyword adds a search keyword. Keywords with spaces are quoted so that
+// they are matched as a phrase.
+func (q *SearchQuery) Keyword(k string) *SearchQuery {
+	return q.add(quoteSearchValue(k))
+}
+
+// Qualifier adds the qualifier name:value, quoting value if needed.
+func (q *SearchQuery) Qualifier(name, value string) *SearchQuery {
+	return q.add(name + ":" + quoteSearchValue(value))
+}
+
+// Repo restricts the search to the repository owner/name.
+func (q *SearchQuery) Repo(owner, name string) *SearchQuery {
+	return q.Qualifier("repo", owner+"/"+name)
+}
+
+// Org restricts the search to the organization org.
+func (q *SearchQuery) Org(org string) *SearchQuery {
+	return q.Qualifier("org", org)
+}
+
+// User restricts the search to the user account user.
+func (q *SearchQuery) User(user string) *SearchQuery {
+	return q.Qualifier("user", user)
+}
+
+// Language restricts the search to the language l.
+func (q *SearchQuery) Language(l string) *SearchQuery {
+	return q.Qualifier("language", l)
+}
+
+// Author restricts the search to results authored by a.
+func (q *SearchQuery) Author(a string) *SearchQuery {
+	return q.Qualifier("author", a)
+}
+
+// Label restricts the search to issues and pull requests labeled l.
+func (q *SearchQuery) Label(l string) *SearchQuery {
+	return q.Qualifier("label", l)
+}
+
+// Created restricts the search to results created between from and to,
+// inclusive, compared by date. A zero from or to leaves that end of the range
+// open. If both are zero, Created does nothing.
+func (q *SearchQuery) Created(from, to time.Time) *SearchQuery {
+	if r := (TimeRange{Since: from, Until: to}).String(); r != "" {
+		return q.add("created:" + r)
+	}
+	return q
+}
+
+// Raw adds s to the query as is, without any quoting. It can be used for
+// qualifiers that have no dedicated method, such as "is:open", or for
+// operators such as "NOT".
+func (q *SearchQuery) Raw(s string) *SearchQuery {
+	return q.add(s)
+}
+
+// String returns the query string, with terms separated by spaces.
+func (q *SearchQuery) String() string {
+	return strings.Join(q.terms, " ")
+}
+
+func (q *SearchQuery) add(term string) *SearchQuery {
+	if term != "" {
+		q.terms = append(q.terms, term)
+	}
+	return q
+}
+
+// quoteSearchValue returns v quoted if it contains whitespace, quotes,
+// colons or parentheses, escaping any quotes and backslashes within it.
+func quoteSearchValue(v string) string {
+	if !strings.ContainsAny(v, " \t\n\":()\\") {
+		return v
+	}
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+	return `"` + v + `"`
+}
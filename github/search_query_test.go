@@ -0,0 +1,42 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSearchQuery(t *testing.T) {
+	from := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		query *SearchQuery
+		want  string
+	}{
+		{new(SearchQuery), ""},
+		{new(SearchQuery).Keyword("gopher").Language("go"), "gopher language:go"},
+		{new(SearchQuery).Keyword("memory leak").Repo("golang", "go"), `"memory leak" repo:golang/go`},
+		{new(SearchQuery).Label("help wanted").Author("octocat"), `label:"help wanted" author:octocat`},
+		{new(SearchQuery).Label("area: api"), `label:"area: api"`},
+		{new(SearchQuery).Keyword(`say "hi"`), `"say \"hi\""`},
+		{new(SearchQuery).Keyword(`C:\path`), `"C:\\path"`},
+		{new(SearchQuery).Language("c++").Org("o").User("u"), "language:c++ org:o user:u"},
+		{new(SearchQuery).Qualifier("topic", "machine learning"), `topic:"machine learning"`},
+		{new(SearchQuery).Created(from, to), "created:2021-01-01..2021-03-31"},
+		{new(SearchQuery).Created(from, time.Time{}), "created:>=2021-01-01"},
+		{new(SearchQuery).Created(time.Time{}, to), "created:<=2021-03-31"},
+		{new(SearchQuery).Created(time.Time{}, time.Time{}).Keyword("x"), "x"},
+		{new(SearchQuery).Raw("is:open").Raw("NOT bug").Keyword(""), "is:open NOT bug"},
+	}
+
+	for _, test := range tests {
+		if got := test.query.String(); got != test.want {
+			t.Errorf("SearchQuery.String() = %q, want %q", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecretScanningService handles communication with the secret scanning
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning
+type SecretScanningService service
+
+// SecretScanningAlert represents a secret found by secret scanning in a
+// repository.
+type SecretScanningAlert struct {
+	Number                *int        `json:"number,omitempty"`
+	CreatedAt             *Timestamp  `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp  `json:"updated_at,omitempty"`
+	URL                   *string     `json:"url,omitempty"`
+	HTMLURL               *string     `json:"html_url,omitempty"`
+	LocationsURL          *string     `json:"locations_url,omitempty"`
+	State                 *string     `json:"state,omitempty"`
+	SecretType            *string     `json:"secret_type,omitempty"`
+	SecretTypeDisplayName *string     `json:"secret_type_display_name,omitempty"`
+	Secret                *string     `json:"secret,omitempty"`
+	Repository            *Repository `json:"repository,omitempty"`
+	// Resolution is the reason the alert was resolved. Possible values are:
+	// false_positive, wont_fix, revoked, used_in_tests.
+	Resolution        *string    `json:"resolution,omitempty"`
+	ResolvedBy        *User      `json:"resolved_by,omitempty"`
+	ResolvedAt        *Timestamp `json:"resolved_at,omitempty"`
+	ResolutionComment *string    `json:"resolution_comment,omitempty"`
+	// Validity reports whether the secret is still active. Possible values
+	// are: active, inactive, unknown.
+	Validity                 *string    `json:"validity,omitempty"`
+	PushProtectionBypassed   *bool      `json:"push_protection_bypassed,omitempty"`
+	PushProtectionBypassedBy *User      `json:"push_protection_bypassed_by,omitempty"`
+	PushProtectionBypassedAt *Timestamp `json:"push_protection_bypassed_at,omitempty"`
+}
+
+func (a SecretScanningAlert) String() string {
+	return Stringify(a)
+}
+
+// SecretScanningAlertListOptions specifies optional parameters to the
+// SecretScanningService methods listing alerts.
+type SecretScanningAlertListOptions struct {
+	// State filters alerts by state. Possible values are: open, resolved.
+	State string `url:"state,omitempty"`
+
+	// SecretType is a comma-separated list of secret types to return, such
+	// as "github_personal_access_token,aws_access_key_id". By default all
+	// secret types are returned.
+	SecretType string `url:"secret_type,omitempty"`
+
+	// Resolution is a comma-separated list of resolutions. Only alerts
+	// resolved with one of them are returned. Possible values are:
+	// false_positive, wont_fix, revoked, pattern_edited, pattern_deleted,
+	// used_in_tests.
+	Resolution string `url:"resolution,omitempty"`
+
+	// Validity is a comma-separated list of validities. Only alerts for
+	// secrets with one of them are returned. Possible values are: active,
+	// inactive, unknown.
+	Validity string `url:"validity,omitempty"`
+
+	ListOptions
+}
+
+// ListAlertsForRepo lists secret scanning alerts for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning#list-secret-scanning-alerts-for-a-repository
+func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForOrg lists secret scanning alerts for the repositories of an
+// organization. The authenticated user must be an administrator or security
+// manager of the organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning#list-secret-scanning-alerts-for-an-organization
+func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/secret-scanning/alerts", org)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForOrgAll lists all secret scanning alerts for the repositories
+// of an organization, following pagination until every page has been
+// fetched. opts.Page is used as the first page to fetch.
+//
+// If a request fails, the alerts fetched so far are returned along with the
+// error.
+func (s *SecretScanningService) ListAlertsForOrgAll(ctx context.Context, org string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	o := new(SecretScanningAlertListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*SecretScanningAlert
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		alerts, resp, err := s.ListAlertsForOrg(ctx, org, o)
+		all = append(all, alerts...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
+// ListAlertsForEnterprise lists secret scanning alerts for the repositories
+// of the organizations owned by an enterprise. The authenticated user must
+// be an enterprise administrator.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning#list-secret-scanning-alerts-for-an-enterprise
+func (s *SecretScanningService) ListAlertsForEnterprise(ctx context.Context, enterprise string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/secret-scanning/alerts", enterprise)
+	return s.listAlerts(ctx, u, opts)
+}
+
+func (s *SecretScanningService) listAlerts(ctx context.Context, u string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*SecretScanningAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// SecretScanningAlertUpdateOptions specifies the parameters to the
+// SecretScanningService.UpdateAlert method.
+type SecretScanningAlertUpdateOptions struct {
+	// State is the new state of the alert. Possible values are: open,
+	// resolved.
+	State string `json:"state"`
+
+	// Resolution is the reason for resolving the alert, and is required when
+	// State is "resolved". Possible values are: false_positive, wont_fix,
+	// revoked, used_in_tests.
+	Resolution string `json:"resolution,omitempty"`
+
+	// ResolutionComment is an optional comment explaining the resolution,
+	// recorded with the alert.
+	ResolutionComment string `json:"resolution_comment,omitempty"`
+}
+
+// UpdateAlert updates the state of a secret scanning alert of a repository,
+// for example to resolve it with a resolution and comment, and returns the
+// updated alert.
+//
+// GitHub API docs: https://docs.github.com/en/rest/secret-scanning#update-a-secret-scanning-alert
+func (s *SecretScanningService) UpdateAlert(ctx context.Context, owner, repo string, number int64, opts *SecretScanningAlertUpdateOptions) (*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("PATCH", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(SecretScanningAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecretScanningService_ListAlertsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"state":       "resolved",
+			"secret_type": "mailchimp_api_key",
+			"resolution":  "revoked,wont_fix",
+			"validity":    "active",
+			"page":        "2",
+		})
+		fmt.Fprint(w, `[{
+			"number": 1,
+			"created_at": "2021-03-26T20:00:00Z",
+			"state": "resolved",
+			"secret_type": "mailchimp_api_key",
+			"repository": {"id": 1, "full_name": "o/r"},
+			"resolution": "revoked",
+			"resolved_by": {"login": "octocat"},
+			"validity": "active"
+		}]`)
+	})
+
+	opts := &SecretScanningAlertListOptions{
+		State:       "resolved",
+		SecretType:  "mailchimp_api_key",
+		Resolution:  "revoked,wont_fix",
+		Validity:    "active",
+		ListOptions: ListOptions{Page: 2},
+	}
+	alerts, _, err := client.SecretScanning.ListAlertsForOrg(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForOrg returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{
+		Number:     Int(1),
+		CreatedAt:  &Timestamp{time.Date(2021, time.March, 26, 20, 0, 0, 0, time.UTC)},
+		State:      String("resolved"),
+		SecretType: String("mailchimp_api_key"),
+		Repository: &Repository{ID: Int64(1), FullName: String("o/r")},
+		Resolution: String("revoked"),
+		ResolvedBy: &User{Login: String("octocat")},
+		Validity:   String("active"),
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForOrg returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_ListAlertsForOrg_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.SecretScanning.ListAlertsForOrg(context.Background(), "%", nil)
+	testURLParseError(t, err)
+}
+
+func TestSecretScanningService_ListAlertsForOrgAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.FormValue("state"), "open"; got != want {
+			t.Errorf("state = %q, want %q", got, want)
+		}
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/secret-scanning/alerts?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"number":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opts := &SecretScanningAlertListOptions{State: "open"}
+	alerts, _, err := client.SecretScanning.ListAlertsForOrgAll(context.Background(), "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForOrgAll returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{Number: Int(1)}, {Number: Int(2)}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForOrgAll returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_ListAlertsForEnterprise(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open"})
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	opts := &SecretScanningAlertListOptions{State: "open"}
+	alerts, _, err := client.SecretScanning.ListAlertsForEnterprise(context.Background(), "e", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForEnterprise returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{Number: Int(1)}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForEnterprise returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_ListAlertsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	alerts, _, err := client.SecretScanning.ListAlertsForRepo(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForRepo returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{Number: Int(1)}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForRepo returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestSecretScanningService_UpdateAlert(t *testing.T) {
+	for _, resolution := range []string{"false_positive", "wont_fix", "revoked", "used_in_tests"} {
+		t.Run(resolution, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/secret-scanning/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PATCH")
+				testBody(t, r, `{"state":"resolved","resolution":"`+resolution+`","resolution_comment":"triaged"}`+"\n")
+				fmt.Fprintf(w, `{"number":42,"state":"resolved","resolution":%q,"resolution_comment":"triaged"}`, resolution)
+			})
+
+			opts := &SecretScanningAlertUpdateOptions{
+				State:             "resolved",
+				Resolution:        resolution,
+				ResolutionComment: "triaged",
+			}
+			alert, _, err := client.SecretScanning.UpdateAlert(context.Background(), "o", "r", 42, opts)
+			if err != nil {
+				t.Errorf("SecretScanning.UpdateAlert returned error: %v", err)
+			}
+
+			want := &SecretScanningAlert{
+				Number:            Int(42),
+				State:             String("resolved"),
+				Resolution:        String(resolution),
+				ResolutionComment: String("triaged"),
+			}
+			if !reflect.DeepEqual(alert, want) {
+				t.Errorf("SecretScanning.UpdateAlert returned %+v, want %+v", alert, want)
+			}
+		})
+	}
+}
+
+func TestSecretScanningService_UpdateAlert_reopen(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"open"}`+"\n")
+		fmt.Fprint(w, `{"number":42,"state":"open"}`)
+	})
+
+	_, _, err := client.SecretScanning.UpdateAlert(context.Background(), "o", "r", 42, &SecretScanningAlertUpdateOptions{State: "open"})
+	if err != nil {
+		t.Errorf("SecretScanning.UpdateAlert returned error: %v", err)
+	}
+}
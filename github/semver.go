@@ -0,0 +1,165 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SortTagsSemver returns the tags whose names are semantic versions, such as
+// "v1.2.3" or "2.0.0-rc.1", sorted from the highest version to the lowest
+// following the precedence rules of https://semver.org. A leading "v" is
+// allowed. Tags whose names are not semantic versions are left out. Tags of
+// equal precedence, such as "v1.0.0" and "1.0.0+build.5", keep their relative
+// order. tags itself is not modified.
+func SortTagsSemver(tags []*RepositoryTag) []*RepositoryTag {
+	type versioned struct {
+		tag *RepositoryTag
+		v   *semver
+	}
+	var vs []versioned
+	for _, tag := range tags {
+		if v, ok := parseSemver(tag.GetName()); ok {
+			vs = append(vs, versioned{tag, v})
+		}
+	}
+
+	sort.SliceStable(vs, func(i, j int) bool {
+		return vs[j].v.less(vs[i].v)
+	})
+
+	sorted := make([]*RepositoryTag, len(vs))
+	for i := range vs {
+		sorted[i] = vs[i].tag
+	}
+	return sorted
+}
+
+// semver is a parsed semantic version. Build metadata is dropped, as it does
+// not take part in precedence.
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string // pre-release identifiers
+}
+
+// parseSemver parses s as a semantic version, with an optional leading "v".
+func parseSemver(s string) (*semver, bool) {
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		if !validIdentifiers(s[i+1:], false) {
+			return nil, false
+		}
+		s = s[:i]
+	}
+	v := new(semver)
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		if !validIdentifiers(s[i+1:], true) {
+			return nil, false
+		}
+		v.pre = strings.Split(s[i+1:], ".")
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+	nums := []*uint64{&v.major, &v.minor, &v.patch}
+	for i, p := range parts {
+		if !isNumericIdentifier(p) {
+			return nil, false
+		}
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		*nums[i] = n
+	}
+	return v, true
+}
+
+// validIdentifiers reports whether s is a non-empty dot-separated list of
+// non-empty alphanumeric identifiers. If numeric is set, numeric identifiers
+// must not have leading zeroes, as required for pre-release versions.
+func validIdentifiers(s string, numeric bool) bool {
+	if s == "" {
+		return false
+	}
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		allDigits := true
+		for _, r := range id {
+			switch {
+			case r >= '0' && r <= '9':
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-':
+				allDigits = false
+			default:
+				return false
+			}
+		}
+		if numeric && allDigits && !isNumericIdentifier(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumericIdentifier reports whether s is a number without leading zeroes.
+func isNumericIdentifier(s string) bool {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// less reports whether v has lower precedence than w.
+func (v *semver) less(w *semver) bool {
+	if v.major != w.major {
+		return v.major < w.major
+	}
+	if v.minor != w.minor {
+		return v.minor < w.minor
+	}
+	if v.patch != w.patch {
+		return v.patch < w.patch
+	}
+
+	// A pre-release version has lower precedence than the release.
+	switch {
+	case len(v.pre) == 0:
+		return false
+	case len(w.pre) == 0:
+		return true
+	}
+	for i := 0; i < len(v.pre) && i < len(w.pre); i++ {
+		a, b := v.pre[i], w.pre[i]
+		if a == b {
+			continue
+		}
+		an, aerr := strconv.ParseUint(a, 10, 64)
+		bn, berr := strconv.ParseUint(b, 10, 64)
+		switch {
+		case aerr == nil && berr == nil:
+			return an < bn
+		case aerr == nil:
+			return true // Numeric identifiers sort before alphanumeric ones.
+		case berr == nil:
+			return false
+		default:
+			return a < b
+		}
+	}
+	return len(v.pre) < len(w.pre)
+}
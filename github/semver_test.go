@@ -0,0 +1,76 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortTagsSemver(t *testing.T) {
+	names := []string{
+		"latest",
+		"v1.0.0-rc.1",
+		"v1.10.0",
+		"1.2.0",
+		"v1.0.0",
+		"release-2021",
+		"v1.0.0-alpha",
+		"v1.0.0-alpha.1",
+		"v1.0.0-beta.11",
+		"v1.0.0-beta.2",
+		"v1.0.0-alpha.beta",
+		"v1.0.0-beta",
+		"v1.2",
+		"v01.2.3",
+		"v2.0.0+build.7",
+		"v1.0.0-01",
+		"v1.9.9",
+	}
+	var tags []*RepositoryTag
+	for _, name := range names {
+		tags = append(tags, &RepositoryTag{Name: String(name)})
+	}
+
+	var got []string
+	for _, tag := range SortTagsSemver(tags) {
+		got = append(got, tag.GetName())
+	}
+
+	want := []string{
+		"v2.0.0+build.7",
+		"v1.10.0",
+		"v1.9.9",
+		"1.2.0",
+		"v1.0.0",
+		"v1.0.0-rc.1",
+		"v1.0.0-beta.11",
+		"v1.0.0-beta.2",
+		"v1.0.0-beta",
+		"v1.0.0-alpha.beta",
+		"v1.0.0-alpha.1",
+		"v1.0.0-alpha",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortTagsSemver returned %v, want %v", got, want)
+	}
+	if tags[0].GetName() != "latest" {
+		t.Errorf("SortTagsSemver modified its input")
+	}
+}
+
+func TestSortTagsSemver_equalPrecedence(t *testing.T) {
+	tags := []*RepositoryTag{
+		{Name: String("1.0.0+b")},
+		{Name: String("v1.0.0")},
+		{Name: String("v1.0.0+a")},
+	}
+
+	got := SortTagsSemver(tags)
+	if !reflect.DeepEqual(got, tags) {
+		t.Errorf("SortTagsSemver reordered tags of equal precedence: %v", got)
+	}
+}
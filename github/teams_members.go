@@ -70,6 +70,28 @@ func (s *TeamsService) ListTeamMembersBySlug(ctx context.Context, org, slug stri
 	return members, resp, nil
 }
 
+// ListTeamMembersBySlugAll lists all of the users who are members of a team,
+// given a specified organization name, by team slug, following pagination
+// until every page has been fetched. opts.Page is used as the first page to
+// fetch, and opts.Role filters the members by role.
+//
+// If a request fails, the members fetched so far are returned along with the
+// error.
+func (s *TeamsService) ListTeamMembersBySlugAll(ctx context.Context, org, slug string, opts *TeamListTeamMembersOptions) ([]*User, *Response, error) {
+	o := new(TeamListTeamMembersOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*User
+	resp, err := paginate(s.client.maxPages, &o.ListOptions, func() (*Response, error) {
+		members, resp, err := s.ListTeamMembersBySlug(ctx, org, slug, o)
+		all = append(all, members...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetTeamMembershipByID returns the membership status for a user in a team, given a specified
 // organization ID, by team ID.
 //
@@ -81,6 +81,41 @@ func TestTeamsService__ListTeamMembersBySlug(t *testing.T) {
 	}
 }
 
+func TestTeamsService__ListTeamMembersBySlugAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/teams/s/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.FormValue("role"), "maintainer"; got != want {
+			t.Errorf("role = %q, want %q", got, want)
+		}
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/teams/s/members?role=maintainer&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":2}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	opt := &TeamListTeamMembersOptions{Role: "maintainer"}
+	members, _, err := client.Teams.ListTeamMembersBySlugAll(context.Background(), "o", "s", opt)
+	if err != nil {
+		t.Errorf("Teams.ListTeamMembersBySlugAll returned error: %v", err)
+	}
+
+	want := []*User{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("Teams.ListTeamMembersBySlugAll returned %+v, want %+v", members, want)
+	}
+	if opt.Page != 0 {
+		t.Errorf("Teams.ListTeamMembersBySlugAll modified opts.Page to %v", opt.Page)
+	}
+}
+
 func TestTeamsService__ListTeamMembersBySlug_notFound(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,46 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"net/url"
+	"time"
+)
+
+// TimeRange represents a range of dates, as used to filter results by date
+// in search queries and in some list endpoints. Both ends are inclusive and
+// compared by date; a zero Since or Until leaves that end of the range open.
+type TimeRange struct {
+	Since time.Time
+	Until time.Time
+}
+
+// String returns the range in the format GitHub expects: "YYYY-MM-DD..YYYY-MM-DD"
+// for a closed range, ">=YYYY-MM-DD" or "<=YYYY-MM-DD" for an open-ended
+// one, and "" if both ends are open.
+func (r TimeRange) String() string {
+	const layout = "2006-01-02"
+	switch {
+	case r.Since.IsZero() && r.Until.IsZero():
+		return ""
+	case r.Since.IsZero():
+		return "<=" + r.Until.Format(layout)
+	case r.Until.IsZero():
+		return ">=" + r.Since.Format(layout)
+	default:
+		return r.Since.Format(layout) + ".." + r.Until.Format(layout)
+	}
+}
+
+// EncodeValues implements the query.Encoder interface, so that TimeRange
+// fields of options structs are added to the URL as formatted by String,
+// and omitted if both ends of the range are open.
+func (r TimeRange) EncodeValues(key string, v *url.Values) error {
+	if s := r.String(); s != "" {
+		v.Set(key, s)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeRange_String(t *testing.T) {
+	since := time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)
+	until := time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		r    TimeRange
+		want string
+	}{
+		{TimeRange{}, ""},
+		{TimeRange{Since: since}, ">=2021-01-02"},
+		{TimeRange{Until: until}, "<=2021-03-04"},
+		{TimeRange{Since: since, Until: until}, "2021-01-02..2021-03-04"},
+	}
+	for _, tt := range tests {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestTimeRange_addOptions(t *testing.T) {
+	since := time.Date(2021, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		opts *ListWorkflowRunsOptions
+		want string
+	}{
+		{&ListWorkflowRunsOptions{}, "runs"},
+		{&ListWorkflowRunsOptions{Created: TimeRange{Since: since}}, "runs?created=%3E%3D2021-01-02"},
+	}
+	for _, tt := range tests {
+		got, err := addOptions("runs", tt.opts)
+		if err != nil {
+			t.Fatalf("addOptions returned error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("addOptions(%+v) = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the token used to authenticate the requests of a
+// client created with Client.WithTokenSource. Token is called for every
+// request, possibly concurrently, so implementations should cache tokens
+// and be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticTokenSource returns a TokenSource that always returns token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// TokenRefreshFunc fetches a new token, such as from a secrets manager, and
+// reports when it expires. A zero expiry means the token should not be
+// reused.
+type TokenRefreshFunc func(ctx context.Context) (token string, expiry time.Time, err error)
+
+// tokenSourceRenewal is how long before it expires a token fetched with a
+// TokenRefreshFunc is refreshed.
+const tokenSourceRenewal = time.Minute
+
+// RefreshTokenSource returns a TokenSource that fetches tokens with refresh
+// and caches each one until shortly before its expiry. Concurrent callers
+// share a single refresh; if it fails, the error is returned and the next
+// call tries again.
+func RefreshTokenSource(refresh TokenRefreshFunc) TokenSource {
+	return &refreshTokenSource{refresh: refresh, now: time.Now}
+}
+
+type refreshTokenSource struct {
+	refresh TokenRefreshFunc
+	now     func() time.Time
+
+	mu     sync.Mutex
+	tok    string
+	expiry time.Time
+}
+
+func (s *refreshTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tok != "" && s.expiry.Sub(s.now()) > tokenSourceRenewal {
+		return s.tok, nil
+	}
+
+	tok, expiry, err := s.refresh(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.tok = tok
+	s.expiry = expiry
+	return tok, nil
+}
+
+// WithTokenSource returns a copy of c (see Clone) whose requests are
+// authenticated with a token obtained from ts for each request, sent in the
+// Authorization header. This lets long-running programs rotate short-lived
+// tokens without rebuilding their clients. The copy reuses the connection
+// pool of c.
+//
+// If ts returns an error, the request is not sent and the error is returned,
+// wrapped in a *url.Error. As with WithAuthToken, an Authorization header set
+// by the transport of c takes precedence.
+func (c *Client) WithTokenSource(ts TokenSource) *Client {
+	c2 := c.Clone()
+	c2.client.Transport = &tokenSourceTransport{
+		source:    ts,
+		Transport: c2.client.Transport,
+	}
+	return c2
+}
+
+// tokenSourceTransport is an http.RoundTripper that authenticates all
+// requests with a token from a TokenSource. It is used by
+// Client.WithTokenSource.
+type tokenSourceTransport struct {
+	source TokenSource
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *tokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.source.Token(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "token "+tok)
+	return t.transport().RoundTrip(req2)
+}
+
+func (t *tokenSourceTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_WithTokenSource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.Header.Get("Authorization"))
+	})
+
+	var tok string
+	ts := RefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+		return tok, time.Time{}, nil
+	})
+	tokenClient := client.WithTokenSource(ts)
+	if tokenClient == client {
+		t.Fatal("WithTokenSource returned the original client")
+	}
+
+	for _, want := range []string{"token a", "token b"} {
+		tok = want[len("token "):]
+		req, _ := tokenClient.NewRequest("GET", ".", nil)
+		var buf bytes.Buffer
+		if _, err := tokenClient.Do(context.Background(), req, &buf); err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("Authorization header is %q, want %q", got, want)
+		}
+	}
+}
+
+func TestClient_WithTokenSource_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent despite token source error")
+	})
+
+	wantErr := errors.New("vault unavailable")
+	ts := RefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+		return "", time.Time{}, wantErr
+	})
+	tokenClient := client.WithTokenSource(ts)
+
+	req, _ := tokenClient.NewRequest("GET", ".", nil)
+	_, err := tokenClient.Do(context.Background(), req, nil)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Do returned error %v, want %v", err, wantErr)
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestTokenSourceTransport_errorClosesBody(t *testing.T) {
+	tr := &tokenSourceTransport{
+		source: RefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+			return "", time.Time{}, errors.New("vault unavailable")
+		}),
+	}
+
+	body := &closeRecorder{Reader: strings.NewReader("{}")}
+	req, _ := http.NewRequest("POST", "https://example.com", body)
+	if _, err := tr.RoundTrip(req); err == nil {
+		t.Fatal("RoundTrip returned no error")
+	}
+	if !body.closed {
+		t.Error("RoundTrip did not close the request body")
+	}
+}
+
+func TestStaticTokenSource(t *testing.T) {
+	got, err := StaticTokenSource("t").Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+	if got != "t" {
+		t.Errorf("Token = %q, want %q", got, "t")
+	}
+}
+
+func TestRefreshTokenSource_caching(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	refreshes := 0
+	ts := &refreshTokenSource{
+		refresh: func(ctx context.Context) (string, time.Time, error) {
+			refreshes++
+			return fmt.Sprintf("t%d", refreshes), now.Add(time.Hour), nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	for i, tt := range []struct {
+		elapsed time.Duration
+		want    string
+	}{
+		{0, "t1"},
+		{30 * time.Minute, "t1"},
+		{59*time.Minute + 30*time.Second, "t2"}, // within tokenSourceRenewal of expiry
+	} {
+		ts.now = func() time.Time { return now.Add(tt.elapsed) }
+		got, err := ts.Token(context.Background())
+		if err != nil {
+			t.Fatalf("#%d: Token returned error: %v", i, err)
+		}
+		if got != tt.want {
+			t.Errorf("#%d: Token = %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestRefreshTokenSource_concurrent(t *testing.T) {
+	var mu sync.Mutex
+	refreshes := 0
+	ts := RefreshTokenSource(func(ctx context.Context) (string, time.Time, error) {
+		mu.Lock()
+		refreshes++
+		mu.Unlock()
+		return "t", time.Now().Add(time.Hour), nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if tok, err := ts.Token(context.Background()); err != nil || tok != "t" {
+				t.Errorf("Token = %q, %v, want %q, nil", tok, err, "t")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if refreshes != 1 {
+		t.Errorf("refresh called %v times, want 1", refreshes)
+	}
+}
@@ -195,6 +195,7 @@ type UserListOptions struct {
 	// Note: Pagination is powered exclusively by the Since parameter,
 	// ListOptions.Page has no effect.
 	// ListOptions.PerPage controls an undocumented GitHub API parameter.
+	// Use ListAllFunc to paginate through all users.
 	ListOptions
 }
 
@@ -223,6 +224,28 @@ func (s *UsersService) ListAll(ctx context.Context, opts *UserListOptions) ([]*U
 	return users, resp, nil
 }
 
+// ListAllFunc lists all GitHub users, calling fn with each page of users in
+// the order they signed up. Each page is requested with the ID of the last
+// user seen, starting after opts.Since. Iteration stops when there are no
+// more users, or when fn returns an error, which is then returned.
+func (s *UsersService) ListAllFunc(ctx context.Context, opts *ListSinceOptions, fn func([]*User) error) (*Response, error) {
+	o := new(ListSinceOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	return paginateSince(s.client.maxPages, o, func() (int64, *Response, error) {
+		users, resp, err := s.ListAll(ctx, &UserListOptions{Since: o.Since, ListOptions: ListOptions{PerPage: o.PerPage}})
+		if err != nil || len(users) == 0 {
+			return 0, resp, err
+		}
+		if err := fn(users); err != nil {
+			return 0, resp, err
+		}
+		return users[len(users)-1].GetID(), resp, nil
+	})
+}
+
 // ListInvitations lists all currently-open repository invitations for the
 // authenticated user.
 //
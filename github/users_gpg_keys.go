@@ -8,7 +8,13 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	pgperrors "golang.org/x/crypto/openpgp/errors"
 )
 
 // GPGKey represents a GitHub user's public GPG key used to verify GPG signed commits and tags.
@@ -16,6 +22,7 @@ import (
 // https://developer.github.com/changes/2016-04-04-git-signing-api-preview/
 type GPGKey struct {
 	ID                *int64      `json:"id,omitempty"`
+	Name              *string     `json:"name,omitempty"`
 	PrimaryKeyID      *int64      `json:"primary_key_id,omitempty"`
 	KeyID             *string     `json:"key_id,omitempty"`
 	PublicKey         *string     `json:"public_key,omitempty"`
@@ -27,6 +34,8 @@ type GPGKey struct {
 	CanCertify        *bool       `json:"can_certify,omitempty"`
 	CreatedAt         *time.Time  `json:"created_at,omitempty"`
 	ExpiresAt         *time.Time  `json:"expires_at,omitempty"`
+	Revoked           *bool       `json:"revoked,omitempty"`
+	RawKey            *string     `json:"raw_key,omitempty"`
 }
 
 // String stringifies a GPGKey.
@@ -72,6 +81,28 @@ func (s *UsersService) ListGPGKeys(ctx context.Context, user string, opts *ListO
 	return keys, resp, nil
 }
 
+// ListGPGKeysAll lists all the public GPG keys for a user, following
+// pagination until every page has been fetched. Passing the empty string
+// will fetch keys for the authenticated user. opts.Page is used as the first
+// page to fetch.
+//
+// If a request fails, the keys fetched so far are returned along with the
+// error.
+func (s *UsersService) ListGPGKeysAll(ctx context.Context, user string, opts *ListOptions) ([]*GPGKey, *Response, error) {
+	o := new(ListOptions)
+	if opts != nil {
+		*o = *opts
+	}
+
+	var all []*GPGKey
+	resp, err := paginate(s.client.maxPages, o, func() (*Response, error) {
+		keys, resp, err := s.ListGPGKeys(ctx, user, o)
+		all = append(all, keys...)
+		return resp, err
+	})
+	return all, resp, err
+}
+
 // GetGPGKey gets extended details for a single GPG key. It requires authentication
 // via Basic Auth or via OAuth with at least read:gpg_key scope.
 //
@@ -114,6 +145,48 @@ func (s *UsersService) CreateGPGKey(ctx context.Context, armoredPublicKey string
 	return key, resp, nil
 }
 
+// ValidateArmoredGPGKey reports whether armoredPublicKey is an ASCII-armored
+// PGP public key block holding at least one well-formed public key, as
+// CreateGPGKey expects. The returned error describes why the key was
+// rejected, wrapping the underlying parse error if any. Private key blocks
+// are rejected.
+//
+// Keys using public key algorithms the parser does not support, such as
+// EdDSA, are accepted without further checks and left for GitHub to
+// validate.
+func ValidateArmoredGPGKey(armoredPublicKey string) error {
+	block, err := armor.Decode(strings.NewReader(armoredPublicKey))
+	if err == io.EOF {
+		return fmt.Errorf("invalid GPG key: no ASCII-armored block found")
+	}
+	if err != nil {
+		return fmt.Errorf("invalid GPG key: decoding armor: %w", err)
+	}
+	if block.Type != openpgp.PublicKeyType {
+		return fmt.Errorf("invalid GPG key: armored block is a %q, want a %q", block.Type, openpgp.PublicKeyType)
+	}
+
+	_, err = openpgp.ReadKeyRing(block.Body)
+	if _, ok := err.(pgperrors.UnsupportedError); ok {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("invalid GPG key: parsing public key: %w", err)
+	}
+	return nil
+}
+
+// CreateValidatedGPGKey is like CreateGPGKey, but first checks
+// armoredPublicKey with ValidateArmoredGPGKey, returning its error without
+// making a request if the key is malformed. This gives a precise reason for
+// the rejection, where GitHub only reports the key as invalid.
+func (s *UsersService) CreateValidatedGPGKey(ctx context.Context, armoredPublicKey string) (*GPGKey, *Response, error) {
+	if err := ValidateArmoredGPGKey(armoredPublicKey); err != nil {
+		return nil, nil, err
+	}
+	return s.CreateGPGKey(ctx, armoredPublicKey)
+}
+
 // DeleteGPGKey deletes a GPG key. It requires authentication via Basic Auth or
 // via OAuth with at least admin:gpg_key scope.
 //
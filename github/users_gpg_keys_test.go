@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -64,6 +65,67 @@ func TestUsersService_ListGPGKeys_invalidUser(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestUsersService_ListGPGKeysAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/users/u/gpg_keys?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{
+				"id":3,"name":"laptop","key_id":"3262EFF25BA0D270","public_key":"xsBNBFayYZ...",
+				"emails":[{"email":"octocat@users.noreply.github.com","verified":true}],
+				"subkeys":[{"id":4,"primary_key_id":3,"key_id":"4A595D4C72EE49C7","can_sign":false,"can_encrypt_comms":true,"can_encrypt_storage":true,"can_certify":false,"created_at":`+referenceTimeStr+`,"expires_at":null}],
+				"can_sign":true,"can_encrypt_comms":false,"can_encrypt_storage":false,"can_certify":true,
+				"created_at":`+referenceTimeStr+`,"expires_at":`+referenceTimeStr+`,"revoked":false,"raw_key":"-----BEGIN PGP PUBLIC KEY BLOCK-----"
+			}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":5}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	keys, _, err := client.Users.ListGPGKeysAll(context.Background(), "u", nil)
+	if err != nil {
+		t.Errorf("Users.ListGPGKeysAll returned error: %v", err)
+	}
+
+	want := []*GPGKey{
+		{
+			ID:        Int64(3),
+			Name:      String("laptop"),
+			KeyID:     String("3262EFF25BA0D270"),
+			PublicKey: String("xsBNBFayYZ..."),
+			Emails:    []*GPGEmail{{Email: String("octocat@users.noreply.github.com"), Verified: Bool(true)}},
+			Subkeys: []*GPGKey{{
+				ID:                Int64(4),
+				PrimaryKeyID:      Int64(3),
+				KeyID:             String("4A595D4C72EE49C7"),
+				CanSign:           Bool(false),
+				CanEncryptComms:   Bool(true),
+				CanEncryptStorage: Bool(true),
+				CanCertify:        Bool(false),
+				CreatedAt:         &referenceTime,
+			}},
+			CanSign:           Bool(true),
+			CanEncryptComms:   Bool(false),
+			CanEncryptStorage: Bool(false),
+			CanCertify:        Bool(true),
+			CreatedAt:         &referenceTime,
+			ExpiresAt:         &referenceTime,
+			Revoked:           Bool(false),
+			RawKey:            String("-----BEGIN PGP PUBLIC KEY BLOCK-----"),
+		},
+		{ID: Int64(5)},
+	}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("Users.ListGPGKeysAll returned %+v, want %+v", keys, want)
+	}
+}
+
 func TestUsersService_GetGPGKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -122,6 +184,70 @@ mQINBFcEd9kBEACo54TDbGhKlXKWMvJgecEUKPPcv7XdnpKdGb3LRw5MvFwT0V0f
 	}
 }
 
+func TestValidateArmoredGPGKey(t *testing.T) {
+	lines := strings.Split(testGPGPublicKey, "\n")
+	truncated := strings.Join(append(lines[:6:6], lines[len(lines)-2:]...), "\n")
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{name: "valid", key: testGPGPublicKey},
+		{name: "surrounding whitespace", key: "\n  " + testGPGPublicKey + "\n"},
+		{name: "truncated", key: truncated, wantErr: "invalid GPG key: "},
+		{name: "not armored", key: "mQINBFcEd9kBEACo54TDbGhKlXKWMvJgecEUKPPcv7XdnpKdGb3LRw5MvFwT0V0f", wantErr: "invalid GPG key: no ASCII-armored block found"},
+		{name: "private key", key: testGPGKey, wantErr: `invalid GPG key: armored block is a "PGP PRIVATE KEY BLOCK", want a "PGP PUBLIC KEY BLOCK"`},
+	}
+	for _, tt := range tests {
+		err := ValidateArmoredGPGKey(tt.key)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("ValidateArmoredGPGKey(%v) returned error: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+			t.Errorf("ValidateArmoredGPGKey(%v) returned error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestUsersService_CreateValidatedGPGKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	key, _, err := client.Users.CreateValidatedGPGKey(context.Background(), testGPGPublicKey)
+	if err != nil {
+		t.Errorf("Users.CreateValidatedGPGKey returned error: %v", err)
+	}
+	if want := (&GPGKey{ID: Int64(1)}); !reflect.DeepEqual(key, want) {
+		t.Errorf("Users.CreateValidatedGPGKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestUsersService_CreateValidatedGPGKey_invalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Users.CreateValidatedGPGKey sent a request for an invalid key")
+	})
+
+	_, resp, err := client.Users.CreateValidatedGPGKey(context.Background(), "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...")
+	if err == nil {
+		t.Error("Users.CreateValidatedGPGKey returned no error for an invalid key")
+	}
+	if resp != nil {
+		t.Errorf("Users.CreateValidatedGPGKey returned response %+v, want nil", resp)
+	}
+}
+
 func TestUsersService_DeleteGPGKey(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -135,3 +261,34 @@ func TestUsersService_DeleteGPGKey(t *testing.T) {
 		t.Errorf("Users.DeleteGPGKey returned error: %v", err)
 	}
 }
+
+const testGPGPublicKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+xsBNBFyi1qYBCAD3EPfLJzIt4qkAceUKkhdvfaIvOsBwXbfr5sSu/lkMqL0Wq47+
+iv+SRwOC7zvN8SlB8nPUgs5dbTRCJJfG5MAqTRR7KZRbyq2jBpi4BtmO30Ul/qId
+3A18cVUfgVbxH85K9bdnyOxep/Q2NjLjTKmWLkzgmgkfbUmSLuWW9HRXPjYy9B7i
+dOFD6GdkN/HwPAaId8ym0TE1mIuSpw8UQHyxusAkK52Pn4h/PgJhLTzbSi1X2eDt
+OgzjhbdxTPzKFQfs97dY8y9C7Bt+CqH6Bvr3785LeKdxiUnCjfUJ+WAoJy780ec+
+IVwSpPp1CaEtzu73w6GH5945GELHE8HRe25FABEBAAHNIGdvLWdpdGh1YiA8Z28t
+Z2l0aHViQGdpdGh1Yi5jb20+wsCUBBMBCAA+FiEELZ6AMqOpBMVblK0uiKTQXVy+
+MAsFAlyi1qYCGwMFCQPCZwAFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQiKTQ
+XVy+MAtEYggA0LRecz71HUjEKXJjC5Wgds1hZ0q+g3ew7zms4fuascd/2PqT5lIt
+HU3oezdzMOHetSPvPzJILjl7RYcYpWvoyzEBC5MutlmuzfwUa7qYCiuRDkYRjke8
+a4o8ijsxc8ANXwulXcI3udjAZdV0CKjrjPTyrHFUnPyZyaZp8p2eX62iPYhaXkoB
+nEiarf0xKtJuT/8IlP5n/redlKYzGIHG5Svg3uDq9E09BOjFsgemhPyqbf7yrh5a
+RwDOIdHtn9mNevFPfQ1jO8lI/wbe4kC6zXM7te0/ZkM06DYRhcaeoYdeyY/gvE+w
+7wU/+f7Wzqt+LxOMIjKk0oDxZIv9praEM87ATQRcotamAQgAsiO75WZvjt7BEAzd
+TvWekWXqBo4NOes2UgzSYToVs6xW8iXnE+mpDS7GHtNQLU6oeC0vizUjCwBfU+qG
+qw1JjI3I1pwv7xRqBIlA6f5ancVKKiMx+/HxasbBrbav8DmZT8E8VaJhYM614Kav
+91W8YoqK5YXmP/A+OwwhkVEGo8v3Iy7mnJPMSjNiNTpiDgc5wvRiTan+uf+AtNPU
+S0k0fbrTZWosbrSmBymhrEy8stMjrG2wZX5aRY7AXrQXoIXedqvP3kW/nqd0wvui
+D11ZZWvoawjZRRVsT27DED0x2+o6aAEKrSLj8LlWvGVkD/jP9lSkC81uwGgD5VIM
+eXv6EQARAQABwsB8BBgBCAAmFiEELZ6AMqOpBMVblK0uiKTQXVy+MAsFAlyi1qYC
+GwwFCQPCZwAACgkQiKTQXVy+MAstJAf/Tm2hfagVjzgJ5pFHmpP+fYxp8dIPZLon
+P5HW12iaSOXThtvWBY578Cb9RmU+WkHyPXg8SyshW7aco4HrUDk+Qmyif9BvHS5R
+sLbyPlhgCqNkn+3QS62fZiIlbHLrQ/6iHXkgLV04Fnj+F4v8YYpOI9nYNFc5iWm0
+zZRcLiRKZk1up8SCngyolcjVuTuCXDKyAUX1jRqDu7tlN0qVH0CYDGchBqTKXNkz
+AvV+CKOyaUILSBBWdef+cxVrDCJuuC3894x3G1FjJycOy0m9PArvGtSGg7/0Bp9o
+LXwiHzFoUMDvx+WlPnPHQNcufmQXUNdZvg+Ad4/unEU81EGDBDz3Eg==
+=UVGw
+-----END PGP PUBLIC KEY BLOCK-----`
@@ -18,6 +18,11 @@ type Key struct {
 	Title     *string    `json:"title,omitempty"`
 	ReadOnly  *bool      `json:"read_only,omitempty"`
 	CreatedAt *Timestamp `json:"created_at,omitempty"`
+
+	// The following fields are only populated for repository deploy keys.
+	Verified *bool      `json:"verified,omitempty"`
+	AddedBy  *string    `json:"added_by,omitempty"`
+	LastUsed *Timestamp `json:"last_used,omitempty"`
 }
 
 func (k Key) String() string {
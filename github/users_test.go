@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -201,6 +202,64 @@ func TestUsersService_ListAll(t *testing.T) {
 	}
 }
 
+func TestUsersService_ListAllFunc(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch since := r.FormValue("since"); since {
+		case "1":
+			testFormValues(t, r, values{"since": "1", "per_page": "2"})
+			fmt.Fprint(w, `[{"id":2},{"id":5}]`)
+		case "5":
+			testFormValues(t, r, values{"since": "5", "per_page": "2"})
+			fmt.Fprint(w, `[{"id":9}]`)
+		case "9":
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected since %q", since)
+		}
+	})
+
+	var got []int64
+	opts := &ListSinceOptions{Since: 1, PerPage: 2}
+	_, err := client.Users.ListAllFunc(context.Background(), opts, func(users []*User) error {
+		for _, u := range users {
+			got = append(got, u.GetID())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Users.ListAllFunc returned error: %v", err)
+	}
+
+	if want := []int64{2, 5, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Users.ListAllFunc listed user IDs %v, want %v", got, want)
+	}
+	if opts.Since != 1 {
+		t.Errorf("Users.ListAllFunc modified opts.Since to %v", opts.Since)
+	}
+}
+
+func TestUsersService_ListAllFunc_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		if since := r.FormValue("since"); since != "" {
+			t.Errorf("Users.ListAllFunc requested another page with since %q after fn failed", since)
+		}
+		fmt.Fprint(w, `[{"id":2}]`)
+	})
+
+	stop := errors.New("stop")
+	_, err := client.Users.ListAllFunc(context.Background(), nil, func([]*User) error { return stop })
+	if err != stop {
+		t.Errorf("Users.ListAllFunc returned error %v, want %v", err, stop)
+	}
+}
+
 func TestUsersService_ListInvitations(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()